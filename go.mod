@@ -6,5 +6,8 @@ require (
 	github.com/aws/aws-sdk-go v1.30.12 // indirect
 	github.com/bndr/gojenkins v1.1.1-0.20210407143218-9e2483ff7ebd
 	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
+	github.com/hashicorp/go-hclog v0.15.0
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.6.1
+	golang.org/x/crypto v0.0.0-20210322153248-0c34fe9e7dc2
+	golang.org/x/net v0.0.0-20210326060303-6b1517762897
 )
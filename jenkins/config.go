@@ -1,54 +1,670 @@
 package jenkins
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	jenkins "github.com/bndr/gojenkins"
+	"golang.org/x/net/http/httpproxy"
 )
 
+// jenkinsClient is the API the provider reaches Jenkins through. Every method takes and returns the provider's own
+// types and reports unsuccessful responses as a statusError. gojenkins remains only behind jenkinsAdapter, which
+// connects and restarts Jenkins through it, and the credentials resources still send its XML credential structs.
 type jenkinsClient interface {
-	CreateJobInFolder(ctx context.Context, config string, jobName string, parentIDs ...string) (*jenkins.Job, error)
-	Credentials() *jenkins.CredentialsManager
+	ApplyConfigurationAsCode(ctx context.Context, yaml string) error
+	CancelQuietDown(ctx context.Context) error
+	CreateJobInFolder(ctx context.Context, config string, jobName string, parentIDs ...string) error
 	DeleteJobInFolder(ctx context.Context, name string, parentIDs ...string) (bool, error)
-	GetJob(ctx context.Context, id string, parentIDs ...string) (*jenkins.Job, error)
-	GetFolder(ctx context.Context, id string, parents ...string) (*jenkins.Folder, error)
+	DeployPlugin(ctx context.Context, pluginURL string) error
+	ExecuteScript(ctx context.Context, script string) (string, error)
+	GetJob(ctx context.Context, id string, parentIDs ...string) (*jobItem, error)
+	GetFolder(ctx context.Context, id string, parents ...string) (*jobItem, error)
+	GetJSON(ctx context.Context, endpoint string, query map[string]string, v interface{}) error
+	GetPlugins(ctx context.Context, depth int) (installedPlugins, error)
+	GetText(ctx context.Context, endpoint string, query map[string]string) (string, error)
+	GetUpdateCenterJobs(ctx context.Context) ([]updateCenterJob, error)
+	Head(ctx context.Context, endpoint string, query map[string]string) (http.Header, error)
+	Post(ctx context.Context, endpoint, contentType, body string) error
+	QuietDown(ctx context.Context, reason string, block bool, timeout time.Duration) error
+	SafeRestart(ctx context.Context) error
+	ServerInfo(ctx context.Context) (*serverInfo, error)
+	ServerVersion(ctx context.Context) (string, error)
+	UninstallPlugin(ctx context.Context, name string) error
+	WhoAmI(ctx context.Context) (*whoAmI, error)
+}
+
+// updateCenterJob describes a unit of work, such as a plugin installation, queued in the update center.
+type updateCenterJob struct {
+	ID           int    `json:"id"`
+	Type         string `json:"type"`
+	Name         string `json:"name"`
+	ErrorMessage string `json:"errorMessage"`
+	Status       struct {
+		Success bool   `json:"success"`
+		Type    string `json:"type"`
+	} `json:"status"`
+}
+
+// jobItem identifies a job or folder by the path it is reached through.
+type jobItem struct {
+	Name string `json:"name"`
+	Base string `json:"-"`
+}
+
+// installedPlugin describes a plugin installed on the controller.
+type installedPlugin struct {
+	ShortName string `json:"shortName"`
+	LongName  string `json:"longName"`
+	Version   string `json:"version"`
+	Enabled   bool   `json:"enabled"`
+	Active    bool   `json:"active"`
+	HasUpdate bool   `json:"hasUpdate"`
+	Deleted   bool   `json:"deleted"`
+}
+
+// installedPlugins lists the plugins installed on the controller.
+type installedPlugins []installedPlugin
+
+// Contains returns the plugin with the given short or long name, or nil if it is not installed.
+func (p installedPlugins) Contains(name string) *installedPlugin {
+	for i := range p {
+		if p[i].ShortName == name || p[i].LongName == name {
+			return &p[i]
+		}
+	}
+	return nil
+}
+
+// serverInfo describes the current state of the controller.
+type serverInfo struct {
+	Mode         string `json:"mode"`
+	NodeName     string `json:"nodeName"`
+	NumExecutors int64  `json:"numExecutors"`
+	QuietingDown bool   `json:"quietingDown"`
+}
+
+// statusError is returned when Jenkins responds with an unsuccessful status, so that callers can act on the
+// status code itself rather than on the wording of the error.
+type statusError struct {
+	StatusCode int
+	Message    string
+	Hint       string
+}
+
+func newStatusError(code int, message string) *statusError {
+	if message == "" {
+		message = http.StatusText(code)
+	}
+	return &statusError{StatusCode: code, Message: message, Hint: errorHint(message)}
+}
+
+func (e *statusError) Error() string {
+	if e.Hint != "" {
+		return fmt.Sprintf("%d %s (%s)", e.StatusCode, e.Message, e.Hint)
+	}
+	return fmt.Sprintf("%d %s", e.StatusCode, e.Message)
+}
+
+// whoAmI describes the identity that requests to Jenkins are authenticated as.
+type whoAmI struct {
+	Name          string   `json:"name"`
+	Authenticated bool     `json:"authenticated"`
+	Anonymous     bool     `json:"anonymous"`
+	Authorities   []string `json:"authorities"`
 }
 
 // jenkinsAdapter wraps the Jenkins client, enabling additional functionality
 type jenkinsAdapter struct {
 	*jenkins.Jenkins
+
+	defaultFolder            string
+	defaultCredentialsDomain string
+	healthEndpoint           string
+	folders                  *folderCache
+	credentials              *credentialsCache
+	plugins                  *pluginCache
 }
 
 // Config is the set of parameters needed to configure the Jenkins provider.
 type Config struct {
 	ServerURL string
-	CACert    io.Reader
+	CACert    []byte
 	Username  string
 	Password  string
+	APIToken  string
+
+	// ClientCert and ClientKey are the PEM-encoded certificate and key to present to controllers requiring mutual TLS
+	ClientCert []byte
+	ClientKey  []byte
+	// InsecureSkipVerify disables verification of the controller's certificate
+	InsecureSkipVerify bool
+
+	// SSHTunnel, when set, carries all connections to the controller through a jump host
+	SSHTunnel *sshTunnel
+
+	// ProxyURL overrides the proxy given by the standard HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables
+	ProxyURL string
+	NoProxy  string
+
+	// MaxRetries is the number of times idempotent requests are retried after a temporary failure. The delays
+	// between retries default to defaultRetryMinDelay and defaultRetryMaxDelay.
+	MaxRetries    int
+	RetryMinDelay time.Duration
+	RetryMaxDelay time.Duration
+
+	// DisableCrumb skips fetching CSRF crumbs, while CacheCrumb reuses the first crumb fetched for all requests
+	DisableCrumb bool
+	CacheCrumb   bool
+
+	// DefaultFolder is prepended to the relative folders of all resources
+	DefaultFolder string
+	// DefaultCredentialsDomain is used for all credentials that do not specify a domain
+	DefaultCredentialsDomain string
+
+	// HealthEndpoint, when set, is checked in place of the API to determine whether Jenkins is ready
+	HealthEndpoint string
+
+	// ReuseSession sends requests with only the session cookie once Jenkins has started a session
+	ReuseSession bool
+
+	// SensitiveFields are the names of the fields whose values are redacted from the logs
+	SensitiveFields []string
+
+	// MaxConcurrentRequests and RequestsPerSecond throttle the provider, with zero meaning no limit
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+
+	// BearerToken replaces basic authentication for controllers behind an authenticating gateway
+	BearerToken string
+	// Headers are sent with every request, such as the identity headers expected by an SSO proxy
+	Headers map[string]string
 }
 
-func newJenkinsClient(c *Config) *jenkinsAdapter {
-	client := jenkins.CreateJenkins(nil, c.ServerURL, c.Username, c.Password)
-	if c.CACert != nil {
-		// provide CA certificate if server is using self-signed certificate
-		client.Requester.CACert, _ = ioutil.ReadAll(c.CACert)
+func newJenkinsClient(c *Config) (*jenkinsAdapter, error) {
+	// Jenkins accepts API tokens in place of the password in basic authentication
+	secret := c.Password
+	if c.APIToken != "" {
+		secret = c.APIToken
+	}
+
+	httpClient, err := newHTTPClient(c)
+	if err != nil {
+		return nil, err
 	}
 
+	// Basic authentication is only used when a user is given, as gateways may authenticate requests instead
+	auth := []interface{}{}
+	if c.Username != "" {
+		auth = append(auth, c.Username, secret)
+	}
+
+	client := jenkins.CreateJenkins(httpClient, normalizeServerURL(c.ServerURL), auth...)
+	client.Requester.CACert = c.CACert
+	client.Requester.SslVerify = !c.InsecureSkipVerify
+
 	// return the Jenkins API client
-	return &jenkinsAdapter{Jenkins: client}
+	return &jenkinsAdapter{
+		Jenkins:                  client,
+		defaultFolder:            c.DefaultFolder,
+		defaultCredentialsDomain: c.DefaultCredentialsDomain,
+		healthEndpoint:           c.HealthEndpoint,
+		folders:                  newFolderCache(folderCacheTTL),
+		credentials:              newCredentialsCache(),
+		plugins:                  newPluginCache(),
+	}, nil
+}
+
+// newHTTPClient prepares the HTTP client used for all requests to the controller.
+func newHTTPClient(c *Config) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if len(c.CACert) > 0 {
+		// Trust the given CA in addition to the system roots, as the controller may be using an internal CA
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(c.CACert) {
+			return nil, fmt.Errorf("could not load CA certificate: no PEM-encoded certificates found")
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if len(c.ClientCert) > 0 || len(c.ClientKey) > 0 {
+		cert, err := tls.X509KeyPair(c.ClientCert, c.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %w", err)
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.SSHTunnel != nil {
+		transport.DialContext = c.SSHTunnel.DialContext
+	}
+
+	if c.ProxyURL != "" {
+		if _, err := url.Parse(c.ProxyURL); err != nil {
+			return nil, fmt.Errorf("could not parse proxy URL: %w", err)
+		}
+
+		proxy := (&httpproxy.Config{
+			HTTPProxy:  c.ProxyURL,
+			HTTPSProxy: c.ProxyURL,
+			NoProxy:    c.NoProxy,
+		}).ProxyFunc()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxy(req.URL)
+		}
+	}
+
+	headers := http.Header{}
+	for key, value := range c.Headers {
+		headers.Set(key, value)
+	}
+	if c.BearerToken != "" {
+		headers.Set("Authorization", "Bearer "+c.BearerToken)
+	}
+
+	// Log closest to the wire, so that each retry is logged along with the final headers
+	secrets := []string{c.Password, c.APIToken, c.BearerToken}
+	for _, value := range c.Headers {
+		secrets = append(secrets, value)
+	}
+	var rt http.RoundTripper = newLoggingTransport(secrets, c.SensitiveFields, transport)
+	if c.DisableCrumb || c.CacheCrumb {
+		rt = &crumbTransport{disabled: c.DisableCrumb, cache: c.CacheCrumb, next: rt}
+	}
+	if len(headers) > 0 {
+		rt = &headerTransport{headers: headers, next: rt}
+	}
+
+	// Cookies are always kept, as load balancers may rely on them to keep requests on the same controller
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.ReuseSession {
+		rt = &sessionTransport{jar: jar, next: rt}
+	}
+	if c.MaxConcurrentRequests > 0 || c.RequestsPerSecond > 0 {
+		rt = newLimitTransport(c.MaxConcurrentRequests, c.RequestsPerSecond, rt)
+	}
+	// Retries are always possible, as the retry blocks of resources apply even when the provider does not retry on its own
+	minDelay, maxDelay := c.RetryMinDelay, c.RetryMaxDelay
+	if minDelay <= 0 {
+		minDelay = defaultRetryMinDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+	rt = &retryTransport{maxRetries: c.MaxRetries, minDelay: minDelay, maxDelay: maxDelay, next: rt}
+
+	return &http.Client{Transport: rt, Jar: jar}, nil
+}
+
+// DefaultFolder is the folder that the relative folders of all resources are placed within.
+func (j *jenkinsAdapter) DefaultFolder() string {
+	return j.defaultFolder
+}
+
+// DefaultCredentialsDomain is the domain that credentials are placed within when they do not specify one.
+func (j *jenkinsAdapter) DefaultCredentialsDomain() string {
+	return j.defaultCredentialsDomain
+}
+
+// CredentialsCache holds the credentials listed from each domain while refreshing.
+func (j *jenkinsAdapter) CredentialsCache() *credentialsCache {
+	return j.credentials
+}
+
+// FolderCache remembers the folders that were recently found to exist.
+func (j *jenkinsAdapter) FolderCache() *folderCache {
+	return j.folders
+}
+
+// PluginCache remembers the plugins that were found to be installed.
+func (j *jenkinsAdapter) PluginCache() *pluginCache {
+	return j.plugins
+}
+
+// HealthEndpoint is the endpoint that determines whether Jenkins is ready, if one has been configured.
+func (j *jenkinsAdapter) HealthEndpoint() string {
+	return j.healthEndpoint
+}
+
+// CheckHealth requests the health endpoint, reporting any non-2xx response as an error.
+func (j *jenkinsAdapter) CheckHealth(ctx context.Context) error {
+	_, err := j.request(ctx, http.MethodGet, j.healthEndpoint, "", nil, nil)
+	return err
+}
+
+// withContext returns a copy of the gojenkins client whose requests are bound to the given context, as gojenkins
+// does not pass the context it is given on to its requests.
+func (j *jenkinsAdapter) withContext(ctx context.Context) *jenkins.Jenkins {
+	next := j.Requester.Client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client := *j.Requester.Client
+	client.Transport = &contextTransport{ctx: ctx, next: next}
+
+	requester := *j.Requester
+	requester.Client = &client
+	bound := *j.Jenkins
+	bound.Requester = &requester
+	return &bound
+}
+
+// Init connects to Jenkins, recording its version.
+func (j *jenkinsAdapter) Init(ctx context.Context) (*jenkins.Jenkins, error) {
+	bound := j.withContext(ctx)
+	if _, err := bound.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	j.Version, j.Raw = bound.Version, bound.Raw
+	return j.Jenkins, nil
 }
 
-func (j *jenkinsAdapter) Credentials() *jenkins.CredentialsManager {
-	return &jenkins.CredentialsManager{
-		J: j.Jenkins,
+// CreateJobInFolder creates a job within the given folders. Unlike the gojenkins implementation, which only reports
+// the status code, rejected configurations are returned as a statusError describing what Jenkins objected to.
+func (j *jenkinsAdapter) CreateJobInFolder(ctx context.Context, config string, jobName string, parentIDs ...string) error {
+	parent := ""
+	if len(parentIDs) > 0 {
+		parent = "/job/" + strings.Join(parentIDs, "/job/")
 	}
+	_, err := j.request(ctx, http.MethodPost, parent+"/createItem", "application/xml;charset=utf-8", strings.NewReader(config), map[string]string{"name": jobName})
+	return err
 }
 
-// DeleteJobInFolder assists in running DeleteJob funcs, as DeleteJob is not folder aware
+// GetPlugins lists the installed plugins.
+func (j *jenkinsAdapter) GetPlugins(ctx context.Context, depth int) (installedPlugins, error) {
+	ret := struct {
+		Plugins installedPlugins `json:"plugins"`
+	}{}
+	if err := j.GetJSON(ctx, "/pluginManager", map[string]string{"depth": strconv.Itoa(depth)}, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret.Plugins, nil
+}
+
+// SafeRestart restarts Jenkins once all running builds have completed.
+func (j *jenkinsAdapter) SafeRestart(ctx context.Context) error {
+	return j.withContext(ctx).SafeRestart(ctx)
+}
+
+// GetFolder fetches a folder. Missing folders are returned as a statusError, as for GetJob.
+func (j *jenkinsAdapter) GetFolder(ctx context.Context, id string, parents ...string) (*jobItem, error) {
+	return j.GetJob(ctx, id, parents...)
+}
+
+// GetJob fetches a job. Unlike the gojenkins implementation, unsuccessful responses are returned as a
+// statusError so that missing jobs can be told apart from other failures.
+func (j *jenkinsAdapter) GetJob(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
+	job := &jobItem{Base: "/job/" + strings.Join(append(parentIDs, id), "/job/")}
+	if err := j.GetJSON(ctx, job.Base, map[string]string{"tree": "name"}, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// DeleteJobInFolder deletes a job within the given folders, as DeleteJob is not folder aware
 // and cannot take a canonical job ID without mishandling it.
 func (j *jenkinsAdapter) DeleteJobInFolder(ctx context.Context, name string, parentIDs ...string) (bool, error) {
-	return j.DeleteJob(ctx, strings.Join(append(parentIDs, name), "/job/"))
+	// The job may be a folder, which would no longer exist along with everything within it
+	j.folders.remove(strings.Join(append(parentIDs, name), "/"))
+
+	// Unlike DeleteJob from gojenkins, unsuccessful responses are returned as a statusError so that
+	// jobs that are already gone, such as along with their folder, can be told apart
+	if _, err := j.request(ctx, http.MethodPost, "/job/"+strings.Join(append(parentIDs, name), "/job/")+"/doDelete", "", nil, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ApplyConfigurationAsCode submits a YAML document to the Configuration as Code plugin,
+// which will apply it on top of the existing controller configuration.
+func (j *jenkinsAdapter) ApplyConfigurationAsCode(ctx context.Context, yaml string) error {
+	_, err := j.request(ctx, http.MethodPost, "/configuration-as-code/apply", "application/x-yaml", strings.NewReader(yaml), nil)
+	return err
+}
+
+// CancelQuietDown ends quiet-down mode, allowing new builds to start again.
+func (j *jenkinsAdapter) CancelQuietDown(ctx context.Context) error {
+	_, err := j.request(ctx, http.MethodPost, "/cancelQuietDown", "", nil, nil)
+	return err
+}
+
+// DeployPlugin installs a plugin and its dependencies from the given .hpi URL, just as the
+// "Deploy Plugin" form of the plugin manager would. Installation continues in the background.
+func (j *jenkinsAdapter) DeployPlugin(ctx context.Context, pluginURL string) error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	// The plugin manager expects an (empty) file upload to precede the URL field
+	if _, err := writer.CreateFormFile("name", ""); err != nil {
+		return err
+	}
+	if err := writer.WriteField("pluginUrl", pluginURL); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	_, err := j.request(ctx, http.MethodPost, "/pluginManager/uploadPlugin", writer.FormDataContentType(), body, nil)
+	return err
+}
+
+// ExecuteScript runs a Groovy script through the Jenkins script console, returning anything it printed.
+func (j *jenkinsAdapter) ExecuteScript(ctx context.Context, script string) (string, error) {
+	payload := url.Values{"script": []string{script}}
+	return j.request(ctx, http.MethodPost, "/scriptText", "application/x-www-form-urlencoded", strings.NewReader(payload.Encode()), nil)
+}
+
+// GetText fetches an endpoint that responds with plain text, such as the console output of a build.
+func (j *jenkinsAdapter) GetText(ctx context.Context, endpoint string, query map[string]string) (string, error) {
+	return j.request(ctx, http.MethodGet, endpoint, "", nil, query)
+}
+
+// GetUpdateCenterJobs lists the jobs known to the update center, oldest first.
+func (j *jenkinsAdapter) GetUpdateCenterJobs(ctx context.Context) ([]updateCenterJob, error) {
+	ret := struct {
+		Jobs []updateCenterJob `json:"jobs"`
+	}{}
+
+	if err := j.GetJSON(ctx, "/updateCenter", map[string]string{"depth": "1"}, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret.Jobs, nil
+}
+
+// Head fetches the response headers of an endpoint without its body, such as to learn the size of a file.
+func (j *jenkinsAdapter) Head(ctx context.Context, endpoint string, query map[string]string) (http.Header, error) {
+	_, header, err := j.requestHeaders(ctx, http.MethodHead, endpoint, "", nil, query)
+	return header, err
+}
+
+// Post submits a document to an endpoint, such as the configuration of a job or credential.
+func (j *jenkinsAdapter) Post(ctx context.Context, endpoint, contentType, body string) error {
+	_, err := j.request(ctx, http.MethodPost, endpoint, contentType, strings.NewReader(body), nil)
+	return err
+}
+
+// QuietDown prevents new builds from starting, displaying the given reason in the UI. If block is set
+// this will also wait up to the given timeout for any running builds to complete.
+func (j *jenkinsAdapter) QuietDown(ctx context.Context, reason string, block bool, timeout time.Duration) error {
+	query := map[string]string{"message": reason}
+	if block {
+		query["block"] = "true"
+		query["timeout"] = strconv.FormatInt(timeout.Milliseconds(), 10)
+	}
+
+	_, err := j.request(ctx, http.MethodPost, "/quietDown", "", nil, query)
+	return err
+}
+
+// ServerInfo fetches the current state of the controller. Unlike Info, controllers that are
+// unavailable (such as while restarting) are reported as an error rather than stale data.
+func (j *jenkinsAdapter) ServerInfo(ctx context.Context) (*serverInfo, error) {
+	ret := &serverInfo{}
+
+	if err := j.GetJSON(ctx, "/", nil, ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// ServerVersion fetches the version of Jenkins, which is only reported through the X-Jenkins header. Unlike the
+// version determined when the provider connected, it reflects upgrades made since then.
+func (j *jenkinsAdapter) ServerVersion(ctx context.Context) (string, error) {
+	_, header, err := j.requestHeaders(ctx, http.MethodGet, "/api/json", "", nil, map[string]string{"tree": "mode"})
+	if err != nil {
+		return "", err
+	}
+
+	return header.Get("X-Jenkins"), nil
+}
+
+// UninstallPlugin marks a plugin for removal, which will take effect once Jenkins is restarted.
+func (j *jenkinsAdapter) UninstallPlugin(ctx context.Context, name string) error {
+	_, err := j.request(ctx, http.MethodPost, fmt.Sprintf("/pluginManager/plugin/%s/doUninstall", url.PathEscape(name)), "", nil, nil)
+	return err
+}
+
+// WhoAmI fetches the identity and authorities of the credentials the client is using.
+func (j *jenkinsAdapter) WhoAmI(ctx context.Context) (*whoAmI, error) {
+	ret := &whoAmI{}
+
+	if err := j.GetJSON(ctx, "/whoAmI", nil, ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// GetJSON fetches the JSON API of the given endpoint into v. Unlike GetJSON from gojenkins, the request is bound to
+// the given context and unsuccessful responses are returned as a statusError, as for every call made through request.
+func (j *jenkinsAdapter) GetJSON(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+	raw, err := j.request(ctx, http.MethodGet, strings.TrimRight(endpoint, "/")+"/api/json", "", nil, query)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(raw), v)
+}
+
+// request performs a raw call against the Jenkins API, returning the response body. Calls are moving here from
+// gojenkins as its coverage or error handling falls short, so that every endpoint is reached the same way.
+// Non-2xx responses are returned as a statusError. Unlike the gojenkins requests, these are bound to the
+// given context and so honor resource timeouts.
+func (j *jenkinsAdapter) request(ctx context.Context, method, endpoint, contentType string, body io.Reader, query map[string]string) (string, error) {
+	ret, _, err := j.requestHeaders(ctx, method, endpoint, contentType, body, query)
+	return ret, err
+}
+
+// setCrumb adds a CSRF crumb to a request. The session cookie the crumb is bound to is kept by the cookie jar of the
+// HTTP client, which sends it along with the request, parsed into name=value pairs. Unlike SetCrumb from gojenkins, the crumb is fetched within the given context and failures to fetch it are returned rather than
+// dereferencing a missing response. Controllers without a crumb issuer are sent no crumb.
+func (j *jenkinsAdapter) setCrumb(ctx context.Context, ar *jenkins.APIRequest) error {
+	raw, err := j.request(ctx, http.MethodGet, "/crumbIssuer/api/json", "", nil, nil)
+	if isNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not fetch a CSRF crumb: %w", err)
+	}
+
+	crumb := struct {
+		Crumb             string `json:"crumb"`
+		CrumbRequestField string `json:"crumbRequestField"`
+	}{}
+	if err := json.Unmarshal([]byte(raw), &crumb); err != nil {
+		// Jenkins rejects the request itself should it need a crumb after all
+		log.Printf("[DEBUG] jenkins::http - Unable to parse the CSRF crumb, sending the request without one: %v", err)
+	} else if crumb.CrumbRequestField != "" {
+		ar.SetHeader(crumb.CrumbRequestField, crumb.Crumb)
+	}
+	return nil
+}
+
+// requestHeaders performs a raw call like request, additionally returning the response headers.
+func (j *jenkinsAdapter) requestHeaders(ctx context.Context, method, endpoint, contentType string, body io.Reader, query map[string]string) (string, http.Header, error) {
+	ar := jenkins.NewAPIRequest(method, endpoint, body)
+	if method == http.MethodPost {
+		if err := j.setCrumb(ctx, ar); err != nil {
+			return "", nil, err
+		}
+	}
+	if contentType != "" {
+		ar.SetHeader("Content-Type", contentType)
+	}
+
+	// Endpoints are relative to the server URL, including any context path, unless given as a full URL
+	endpoint = j.Requester.Base + ar.Endpoint
+	if strings.HasPrefix(ar.Endpoint, "http://") || strings.HasPrefix(ar.Endpoint, "https://") {
+		endpoint = ar.Endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", nil, err
+	}
+	values := u.Query()
+	for key, val := range query {
+		values.Set(key, val)
+	}
+	u.RawQuery = values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), ar.Payload)
+	if err != nil {
+		return "", nil, err
+	}
+	if j.Requester.BasicAuth != nil {
+		req.SetBasicAuth(j.Requester.BasicAuth.Username, j.Requester.BasicAuth.Password)
+	}
+	for key := range ar.Headers {
+		req.Header.Set(key, ar.Headers.Get(key))
+	}
+
+	resp, err := j.Requester.Client.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, err
+	}
+	ret := string(raw)
+
+	// Error pages are reduced to the exception they report, as their stack traces would bury it otherwise
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if errorText := resp.Header.Get("X-Error"); errorText != "" {
+			return ret, resp.Header, newStatusError(resp.StatusCode, errorText)
+		}
+		return ret, resp.Header, newStatusError(resp.StatusCode, describeErrorPage(ret))
+	}
+
+	return ret, resp.Header, nil
 }
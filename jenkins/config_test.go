@@ -1,61 +1,570 @@
 package jenkins
 
 import (
-	"bytes"
 	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
-
-	jenkins "github.com/bndr/gojenkins"
+	"time"
 )
 
 type mockJenkinsClient struct {
-	mockCreateJobInFolder func(ctx context.Context, config string, jobName string, parentIDs ...string) (*jenkins.Job, error)
-	mockDeleteJobInFolder func(ctx context.Context, name string, parentIDs ...string) (bool, error)
-	mockGetJob            func(ctx context.Context, id string, parentIDs ...string) (*jenkins.Job, error)
-	mockGetFolder         func(ctx context.Context, id string, parentIDs ...string) (*jenkins.Folder, error)
+	mockApplyConfigurationAsCode func(ctx context.Context, yaml string) error
+	mockCancelQuietDown          func(ctx context.Context) error
+	mockCreateJobInFolder        func(ctx context.Context, config string, jobName string, parentIDs ...string) error
+	mockDeleteJobInFolder        func(ctx context.Context, name string, parentIDs ...string) (bool, error)
+	mockDeployPlugin             func(ctx context.Context, pluginURL string) error
+	mockExecuteScript            func(ctx context.Context, script string) (string, error)
+	mockGetJob                   func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error)
+	mockGetFolder                func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error)
+	mockGetPlugins               func(ctx context.Context, depth int) (installedPlugins, error)
+	mockGetText                  func(ctx context.Context, endpoint string, query map[string]string) (string, error)
+	mockGetJSON                  func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error
+	mockGetUpdateCenterJobs      func(ctx context.Context) ([]updateCenterJob, error)
+	mockHead                     func(ctx context.Context, endpoint string, query map[string]string) (http.Header, error)
+	mockPost                     func(ctx context.Context, endpoint, contentType, body string) error
+	mockQuietDown                func(ctx context.Context, reason string, block bool, timeout time.Duration) error
+	mockSafeRestart              func(ctx context.Context) error
+	mockServerInfo               func(ctx context.Context) (*serverInfo, error)
+	mockServerVersion            func(ctx context.Context) (string, error)
+	mockUninstallPlugin          func(ctx context.Context, name string) error
+	mockWhoAmI                   func(ctx context.Context) (*whoAmI, error)
 }
 
-func (m *mockJenkinsClient) CreateJobInFolder(ctx context.Context, config string, jobName string, parentIDs ...string) (*jenkins.Job, error) {
-	return m.mockCreateJobInFolder(ctx, config, jobName, parentIDs...)
+func (m *mockJenkinsClient) ApplyConfigurationAsCode(ctx context.Context, yaml string) error {
+	return m.mockApplyConfigurationAsCode(ctx, yaml)
 }
 
-func (m *mockJenkinsClient) Credentials() *jenkins.CredentialsManager {
-	return &jenkins.CredentialsManager{}
+func (m *mockJenkinsClient) CancelQuietDown(ctx context.Context) error {
+	return m.mockCancelQuietDown(ctx)
+}
+
+func (m *mockJenkinsClient) CreateJobInFolder(ctx context.Context, config string, jobName string, parentIDs ...string) error {
+	return m.mockCreateJobInFolder(ctx, config, jobName, parentIDs...)
 }
 
 func (m *mockJenkinsClient) DeleteJobInFolder(ctx context.Context, name string, parentIDs ...string) (bool, error) {
 	return m.mockDeleteJobInFolder(ctx, name, parentIDs...)
 }
 
-func (m *mockJenkinsClient) GetJob(ctx context.Context, id string, parentIDs ...string) (*jenkins.Job, error) {
+func (m *mockJenkinsClient) DeployPlugin(ctx context.Context, pluginURL string) error {
+	return m.mockDeployPlugin(ctx, pluginURL)
+}
+
+func (m *mockJenkinsClient) ExecuteScript(ctx context.Context, script string) (string, error) {
+	return m.mockExecuteScript(ctx, script)
+}
+
+func (m *mockJenkinsClient) GetJob(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
 	return m.mockGetJob(ctx, id, parentIDs...)
 }
 
-func (m *mockJenkinsClient) GetFolder(ctx context.Context, id string, parentIDs ...string) (*jenkins.Folder, error) {
+func (m *mockJenkinsClient) GetFolder(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
 	return m.mockGetFolder(ctx, id, parentIDs...)
 }
 
+func (m *mockJenkinsClient) GetPlugins(ctx context.Context, depth int) (installedPlugins, error) {
+	return m.mockGetPlugins(ctx, depth)
+}
+
+func (m *mockJenkinsClient) GetJSON(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+	return m.mockGetJSON(ctx, endpoint, query, v)
+}
+
+func (m *mockJenkinsClient) GetText(ctx context.Context, endpoint string, query map[string]string) (string, error) {
+	return m.mockGetText(ctx, endpoint, query)
+}
+
+func (m *mockJenkinsClient) GetUpdateCenterJobs(ctx context.Context) ([]updateCenterJob, error) {
+	return m.mockGetUpdateCenterJobs(ctx)
+}
+
+func (m *mockJenkinsClient) Head(ctx context.Context, endpoint string, query map[string]string) (http.Header, error) {
+	return m.mockHead(ctx, endpoint, query)
+}
+
+func (m *mockJenkinsClient) Post(ctx context.Context, endpoint, contentType, body string) error {
+	return m.mockPost(ctx, endpoint, contentType, body)
+}
+
+func (m *mockJenkinsClient) QuietDown(ctx context.Context, reason string, block bool, timeout time.Duration) error {
+	return m.mockQuietDown(ctx, reason, block, timeout)
+}
+
+func (m *mockJenkinsClient) SafeRestart(ctx context.Context) error {
+	return m.mockSafeRestart(ctx)
+}
+
+func (m *mockJenkinsClient) ServerInfo(ctx context.Context) (*serverInfo, error) {
+	return m.mockServerInfo(ctx)
+}
+
+func (m *mockJenkinsClient) ServerVersion(ctx context.Context) (string, error) {
+	return m.mockServerVersion(ctx)
+}
+
+func (m *mockJenkinsClient) UninstallPlugin(ctx context.Context, name string) error {
+	return m.mockUninstallPlugin(ctx, name)
+}
+
+func (m *mockJenkinsClient) WhoAmI(ctx context.Context) (*whoAmI, error) {
+	return m.mockWhoAmI(ctx)
+}
+
 func TestNewJenkinsClient(t *testing.T) {
-	c := newJenkinsClient(&Config{})
+	c, _ := newJenkinsClient(&Config{})
 	if c == nil {
 		t.Errorf("Expected populated client")
 	}
 
-	c = newJenkinsClient(&Config{
-		CACert: bytes.NewBufferString("certificate"),
+	_, err := newJenkinsClient(&Config{
+		CACert: []byte("certificate"),
 	})
-	if string(c.Requester.CACert) != "certificate" {
-		t.Errorf("Initialization did not extract certificate data")
+	if err == nil {
+		t.Errorf("Expected an invalid CA certificate to be rejected")
+	}
+
+	c, _ = newJenkinsClient(&Config{
+		Username: "admin",
+		Password: "password",
+		APIToken: "token",
+	})
+	if c.Requester.BasicAuth.Password != "token" {
+		t.Errorf("Expected the API token to take precedence over the password")
+	}
+
+	_, err = newJenkinsClient(&Config{
+		ClientCert: []byte("certificate"),
+		ClientKey:  []byte("key"),
+	})
+	if err == nil {
+		t.Errorf("Expected an invalid client certificate to be rejected")
 	}
 }
 
-func TestJenkinsAdapter_Credentials(t *testing.T) {
-	c := newJenkinsClient(&Config{})
-	cm := c.Credentials()
+func TestNewHTTPClient_CACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
 
-	if cm == nil {
-		t.Errorf("Expected populated client")
-	} else if cm.J != c.Jenkins {
-		t.Error("Expected credentials client to match client")
+	// Untrusted certificates are rejected by default
+	client, err := newHTTPClient(&Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(server.URL); err == nil {
+		t.Errorf("Expected the untrusted server certificate to be rejected")
+	}
+
+	// Unless their CA is provided
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	client, err = newHTTPClient(&Config{CACert: ca})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(server.URL); err != nil {
+		t.Errorf("Expected the server certificate to be trusted, received %v", err)
+	}
+
+	// Or verification is disabled
+	client, err = newHTTPClient(&Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(server.URL); err != nil {
+		t.Errorf("Expected the server certificate to be ignored, received %v", err)
+	}
+}
+
+func TestNewHTTPClient_Proxy(t *testing.T) {
+	client, err := newHTTPClient(&Config{
+		ProxyURL: "socks5://proxy.example.com:1080",
+		NoProxy:  "internal.example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy := client.Transport.(*retryTransport).next.(*loggingTransport).next.(*http.Transport).Proxy
+
+	req, _ := http.NewRequest(http.MethodGet, "https://jenkins.example.com", nil)
+	if actual, _ := proxy(req); actual == nil || actual.String() != "socks5://proxy.example.com:1080" {
+		t.Errorf("Expected requests to be sent through the proxy, received %v", actual)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, "https://internal.example.com", nil)
+	if actual, _ := proxy(req); actual != nil {
+		t.Errorf("Expected excluded hosts to bypass the proxy, received %v", actual)
+	}
+}
+
+func TestJenkinsAdapter_withContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Second)
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+
+	// Requests made through gojenkins are abandoned along with the operation
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.Init(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the request to be abandoned at the deadline, received %v", err)
+	}
+}
+
+func TestCredentialsManager_parallel(t *testing.T) {
+	var mu sync.Mutex
+	deleted := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		deleted[r.URL.Path] = true
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL, DisableCrumb: true})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(folder string) {
+			defer wg.Done()
+			if err := deleteCredentials(context.Background(), c, &credentialsManager{Folder: folder}, "_", "deploy"); err != nil {
+				t.Errorf("Expected the credentials in %s to be deleted but received: %s", folder, err)
+			}
+		}(fmt.Sprintf("team-%d", i))
+	}
+	wg.Wait()
+
+	for i := 0; i < 10; i++ {
+		path := fmt.Sprintf("/job/team-%d/credentials/store/folder/domain/_/credential/deploy/doDelete", i)
+		if !deleted[path] {
+			t.Errorf("Expected the credentials to be deleted from %s, received %v", path, deleted)
+		}
+	}
+}
+
+func TestNewHTTPClient_Headers(t *testing.T) {
+	received := http.Header{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+	}))
+	defer server.Close()
+
+	client, err := newHTTPClient(&Config{Headers: map[string]string{"X-Tenant": "team-a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(server.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	if received.Get("X-Tenant") != "team-a" {
+		t.Errorf("Expected the custom header to be sent, received %q", received.Get("X-Tenant"))
+	}
+}
+
+func TestJenkinsAdapter_request_timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/scriptText" {
+			time.Sleep(time.Second)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.ExecuteScript(ctx, "println 'hello'"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the request to be abandoned at the deadline, received %v", err)
+	}
+}
+
+func TestJenkinsAdapter_getJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimRight(r.URL.Path, "/") != "/whoAmI/api/json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"name": "admin", "authenticated": true}`)
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	if info, err := c.WhoAmI(context.Background()); err != nil || info.Name != "admin" {
+		t.Errorf("Expected the identity to be decoded but received %v: %v", info, err)
+	}
+
+	// Unsuccessful responses carry their status code
+	if _, err := c.ServerInfo(context.Background()); !isNotFound(err) {
+		t.Errorf("Expected the missing endpoint to be reported, received %v", err)
+	}
+}
+
+func TestJenkinsAdapter_request_contextPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ci/jenkins/quietDown" {
+			w.WriteHeader(http.StatusNotFound)
+		} else if r.URL.Query().Get("message") != "maintenance" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL + "/ci/jenkins/", DisableCrumb: true})
+	if err := c.QuietDown(context.Background(), "maintenance", false, 0); err != nil {
+		t.Errorf("Expected the request to be made under the context path, received %v", err)
+	}
+}
+
+func TestJenkinsAdapter_GetJob_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("<html><body>Not Found</body></html>"))
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	if _, err := c.GetJob(context.Background(), "missing", "folder"); !isNotFound(err) {
+		t.Errorf("Expected the job to be reported as missing, received %v", err)
+	}
+	if _, err := c.GetFolder(context.Background(), "missing"); !isNotFound(err) {
+		t.Errorf("Expected the folder to be reported as missing, received %v", err)
+	}
+}
+
+func TestJenkinsAdapter_GetJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/job/team/job/build/api/json":
+			fmt.Fprint(w, `{"name": "build"}`)
+		case "/pluginManager/api/json":
+			fmt.Fprint(w, `{"plugins": [{"shortName": "git", "longName": "Git plugin", "version": "5.2.0", "active": true}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	if job, err := c.GetJob(context.Background(), "build", "team"); err != nil || job.Name != "build" || job.Base != "/job/team/job/build" {
+		t.Errorf("Expected the job to be located but received %+v: %v", job, err)
+	}
+
+	plugins, err := c.GetPlugins(context.Background(), 1)
+	if plugin := plugins.Contains("Git plugin"); err != nil || plugin == nil || plugin.ShortName != "git" || !plugin.Active {
+		t.Errorf("Expected the plugin to be listed but received %+v: %v", plugins, err)
+	}
+}
+
+func TestJenkinsAdapter_CreateJobInFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/job/team/createItem" && r.URL.Query().Get("name") == "build":
+			body, _ := ioutil.ReadAll(r.Body)
+			if !strings.Contains(string(body), "<project>") {
+				w.Header().Set("Content-Type", "text/html")
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprint(w, "<html><body><pre>com.thoughtworks.xstream.mapper.CannotResolveClassException: unknown\n\tat com.thoughtworks.xstream.mapper.DefaultMapper.realClass(DefaultMapper.java:81)</pre></body></html>")
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL, DisableCrumb: true})
+	if err := c.CreateJobInFolder(context.Background(), "<project></project>", "build", "team"); err != nil {
+		t.Errorf("Expected the job to be created but received %v", err)
+	}
+
+	err := c.CreateJobInFolder(context.Background(), "<unknown></unknown>", "build", "team")
+	var status *statusError
+	if !errors.As(err, &status) || status.Message != "com.thoughtworks.xstream.mapper.CannotResolveClassException: unknown" || status.Hint == "" {
+		t.Errorf("Expected the rejected configuration to be described, received %v", err)
+	}
+}
+
+func TestJenkinsAdapter_DeleteJobInFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/job/team/job/build/doDelete" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL, DisableCrumb: true})
+	if ok, err := c.DeleteJobInFolder(context.Background(), "build", "team"); !ok || err != nil {
+		t.Errorf("Expected the job to be deleted, received %t and %v", ok, err)
+	}
+	if _, err := c.DeleteJobInFolder(context.Background(), "build", "removed"); !isNotFound(err) {
+		t.Errorf("Expected the job to be reported as missing, received %v", err)
+	}
+}
+
+func TestJenkinsAdapter_ServerVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Jenkins", "2.426.1")
+		fmt.Fprint(w, `{"mode": "NORMAL"}`)
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	if version, err := c.ServerVersion(context.Background()); err != nil || version != "2.426.1" {
+		t.Errorf("Expected the version to be read from the header but received %q: %v", version, err)
+	}
+}
+
+func TestJenkinsAdapter_Head(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead || r.URL.Path != "/job/build/1/artifact/manifest.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", "42")
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	if header, err := c.Head(context.Background(), "/job/build/1/artifact/manifest.yaml", nil); err != nil || header.Get("Content-Length") != "42" {
+		t.Errorf("Expected the headers to be returned but received %v: %v", header, err)
+	}
+	if _, err := c.Head(context.Background(), "/job/build/1/artifact/missing.yaml", nil); !isNotFound(err) {
+		t.Errorf("Expected the missing file to be reported, received %v", err)
+	}
+}
+
+func TestJenkinsAdapter_request_crumb(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crumbIssuer/api/json":
+			w.Header().Add("Set-Cookie", "JSESSIONID=abc; Path=/; HttpOnly")
+			w.Header().Add("Set-Cookie", "AWSALB=node-1; Path=/")
+			fmt.Fprint(w, `{"crumb": "secret", "crumbRequestField": "Jenkins-Crumb"}`)
+		case "/quietDown":
+			// Only the name=value pairs of the cookies are sent back, once each
+			cookies := r.Header.Values("Cookie")
+			if r.Header.Get("Jenkins-Crumb") != "secret" || len(cookies) != 1 || cookies[0] != "JSESSIONID=abc; AWSALB=node-1" {
+				w.WriteHeader(http.StatusForbidden)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	if err := c.QuietDown(context.Background(), "maintenance", false, 0); err != nil {
+		t.Errorf("Expected the crumb to be sent along with its session, received %v", err)
+	}
+}
+
+func TestJenkinsAdapter_request_xError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Error", "No such job")
+		if r.URL.Path != "/job/build/config.xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, "<project/>")
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	var status *statusError
+	if _, err := c.GetText(context.Background(), "/job/missing/config.xml", nil); !errors.As(err, &status) || status.Message != "No such job" {
+		t.Errorf("Expected the error header to describe the failure, received %v", err)
+	}
+
+	// Successful responses are kept even when they carry an error header
+	if config, err := c.GetText(context.Background(), "/job/build/config.xml", nil); err != nil || config != "<project/>" {
+		t.Errorf("Expected the configuration to be returned, received %q: %v", config, err)
+	}
+}
+
+func TestJenkinsAdapter_request_crumbUnreachable(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/crumbIssuer/api/json" {
+			// Drop the connection without responding, as an unreachable controller would
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		posted = true
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	if err := c.QuietDown(context.Background(), "maintenance", false, 0); err == nil || !strings.Contains(err.Error(), "CSRF crumb") {
+		t.Errorf("Expected the failure to fetch a crumb to be reported, received %v", err)
+	}
+	if posted {
+		t.Error("Expected the request not to be sent without a crumb")
+	}
+
+	// Crumbs are fetched within the operation, and so are abandoned along with it
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := c.QuietDown(ctx, "maintenance", false, 0); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the crumb to be fetched within the context, received %v", err)
+	}
+}
+
+func TestNewHTTPClient_resourceRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	// Disabling the retries of the provider leaves those asked for by resources in place
+	client, err := newHTTPClient(&Config{MaxRetries: 0, RetryMinDelay: time.Millisecond, RetryMaxDelay: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := withRetryPolicy(context.Background(), &retryPolicy{attempts: 2, onStatus: map[int]bool{http.StatusBadRequest: true}, backoff: time.Millisecond})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || attempts != 2 {
+		t.Errorf("Expected the resource's retry policy to apply, received %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestNewHTTPClient_restartWithoutRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	// Waiting for a restart is bounded by time rather than by max_retries
+	client, err := newHTTPClient(&Config{MaxRetries: 0, RetryMinDelay: time.Millisecond, RetryMaxDelay: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || attempts != 3 {
+		t.Errorf("Expected the restart to be waited through, received %d after %d attempts", resp.StatusCode, attempts)
 	}
 }
@@ -0,0 +1,277 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// credentialsListScript describes every credential within a domain of a store, so that a whole domain can be refreshed
+// with a single request. Only the fields that are read back into resources are included, in documents shaped like
+// those of the config.xml endpoint, so that secrets are never sent through the script console.
+const credentialsListScript = `
+	def context = input.folder ? jenkins.model.Jenkins.get().getItemByFullName(input.folder) : jenkins.model.Jenkins.get()
+	if (context == null) {
+		return [:]
+	}
+	def store = com.cloudbees.plugins.credentials.CredentialsProvider.lookupStores(context).find { it.context == context }
+	def domain = input.domain == '_' ? com.cloudbees.plugins.credentials.domains.Domain.global() : store?.getDomainByName(input.domain)
+	if (store == null || domain == null) {
+		return [:]
+	}
+	def mapper = hudson.model.Items.XSTREAM2.mapper
+	return store.getCredentials(domain).collectEntries { credential ->
+		def fields = [id: credential.id, scope: credential.scope, description: credential.description]
+		['username', 'fileName', 'path', 'roleId'].each { name ->
+			if (credential.hasProperty(name)) {
+				fields[name] = credential."${name}"
+			}
+		}
+		def element = mapper.serializedClass(credential.class)
+		def body = fields.collect { name, value -> "<${name}>" + groovy.xml.XmlUtil.escapeXml(value?.toString() ?: '') + "</${name}>" }.join('')
+		[(credential.id): "<${element}>" + body + "</${element}>"]
+	}
+`
+
+// credentialsUsageScript lists the jobs and nodes that Jenkins recorded a credential as used by, through the
+// fingerprint kept by the credentials plugin's usage tracking.
+const credentialsUsageScript = `
+	def context = input.folder ? jenkins.model.Jenkins.get().getItemByFullName(input.folder) : jenkins.model.Jenkins.get()
+	def store = context == null ? null : com.cloudbees.plugins.credentials.CredentialsProvider.lookupStores(context).find { it.context == context }
+	def domain = input.domain == '_' ? com.cloudbees.plugins.credentials.domains.Domain.global() : store?.getDomainByName(input.domain)
+	def credential = domain == null ? null : store.getCredentials(domain).find { it.id == input.id }
+	def fingerprint = credential == null ? null : com.cloudbees.plugins.credentials.CredentialsProvider.getFingerprintOf(credential)
+	if (fingerprint == null) {
+		return [jobs: [], nodes: []]
+	}
+	return [
+		jobs: fingerprint.jobs,
+		nodes: fingerprint.facets.findAll { it instanceof com.cloudbees.plugins.credentials.fingerprints.NodeCredentialsFingerprintFacet }.collect { it.nodeName },
+	]
+`
+
+// credentialsManager locates the credentials store of a folder, or of Jenkins itself when Folder is empty.
+// Resources create one for each operation, so that resources applied in parallel never share a Folder.
+type credentialsManager struct {
+	Folder string
+}
+
+// credentialsUsage describes where Jenkins recorded a credential as used.
+type credentialsUsage struct {
+	Jobs  []string `json:"jobs"`
+	Nodes []string `json:"nodes"`
+}
+
+// preventDestroyIfInUse refuses to delete credentials that Jenkins recorded as used by any job or node,
+// if the resource asks for it through "prevent_destroy_if_in_use".
+func preventDestroyIfInUse(ctx context.Context, client jenkinsClient, d *schema.ResourceData, folder string, domain string) error {
+	if !d.Get("prevent_destroy_if_in_use").(bool) {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	input := map[string]string{"folder": strings.Join(extractFolders(folder), "/"), "domain": domain, "id": name}
+	usage := credentialsUsage{}
+	if err := executeScript(ctx, client, credentialsUsageScript, input, &usage); err != nil {
+		return fmt.Errorf("jenkins::delete - Could not determine where credentials %q are in use: %w", name, err)
+	}
+
+	users := []string{}
+	if len(usage.Jobs) > 0 {
+		users = append(users, "jobs "+strings.Join(usage.Jobs, ", "))
+	}
+	if len(usage.Nodes) > 0 {
+		users = append(users, "nodes "+strings.Join(usage.Nodes, ", "))
+	}
+	if len(users) > 0 {
+		return fmt.Errorf("jenkins::delete - Credentials %q are in use by %s. Set \"prevent_destroy_if_in_use\" to false to delete them anyway", name, strings.Join(users, " and "))
+	}
+
+	log.Printf("[DEBUG] jenkins::delete - Credentials %q are not in use", name)
+	return nil
+}
+
+// getCredentials fetches the configuration of a single credential into creds. Unlike GetSingle from gojenkins,
+// unsuccessful responses are returned as a statusError so that missing credentials can be told apart.
+//
+// The credential is served from the listing of its domain when the client keeps a credentials cache,
+// so that refreshing many credentials in the same domain needs only one request.
+func getCredentials(ctx context.Context, client jenkinsClient, cm *credentialsManager, domain string, id string, creds interface{}) error {
+	if raw, ok := credentialsCacheOf(client).get(ctx, client, cm.Folder, domain, id); ok {
+		return xml.Unmarshal([]byte(raw), creds)
+	}
+
+	raw, err := client.GetText(ctx, credentialURL(cm, domain, id)+"/config.xml", nil)
+	if err != nil {
+		return err
+	}
+
+	return xml.Unmarshal([]byte(raw), creds)
+}
+
+// createCredentials adds a credential to a domain. Unlike Add from gojenkins, unsuccessful responses are returned
+// as a statusError describing what Jenkins objected to.
+func createCredentials(ctx context.Context, client jenkinsClient, cm *credentialsManager, domain string, creds interface{}) error {
+	payload, err := xml.Marshal(creds)
+	if err != nil {
+		return err
+	}
+
+	credentialsCacheOf(client).forget(cm.Folder, domain)
+	return client.Post(ctx, credentialsDomainURL(cm, domain)+"/createCredentials", "application/xml;charset=utf-8", string(payload))
+}
+
+// updateCredentials replaces the configuration of a single credential. Unlike Update from gojenkins, unsuccessful
+// responses are returned as a statusError, so that credentials removed outside of Terraform can be told apart.
+func updateCredentials(ctx context.Context, client jenkinsClient, cm *credentialsManager, domain string, id string, creds interface{}) error {
+	payload, err := xml.Marshal(creds)
+	if err != nil {
+		return err
+	}
+
+	credentialsCacheOf(client).forget(cm.Folder, domain)
+	return client.Post(ctx, credentialURL(cm, domain, id)+"/config.xml", "application/xml;charset=utf-8", string(payload))
+}
+
+// deleteCredentials removes a single credential. Unlike Delete from gojenkins, unsuccessful responses are returned
+// as a statusError so that credentials that are already gone, such as along with their folder, can be told apart.
+func deleteCredentials(ctx context.Context, client jenkinsClient, cm *credentialsManager, domain string, id string) error {
+	credentialsCacheOf(client).forget(cm.Folder, domain)
+	return client.Post(ctx, credentialURL(cm, domain, id)+"/doDelete", "", "")
+}
+
+// credentialURL locates a credential within the store of the credentials manager's folder.
+func credentialURL(cm *credentialsManager, domain string, id string) string {
+	return fmt.Sprintf("%s/credential/%s", credentialsDomainURL(cm, domain), id)
+}
+
+// credentialsDomainURL locates a domain within the store of the credentials manager's folder.
+// These store URLs replaced the "credential-store" ones in version 2.0 of the credentials plugin, and the XML
+// config.xml and createCredentials endpoints below them were added in 2.1.0. Both predate the oldest supported
+// version, minimumCredentialsVersion, so there are no older formats to fall back to.
+func credentialsDomainURL(cm *credentialsManager, domain string) string {
+	store := "/credentials/store/system"
+	if cm.Folder != "" {
+		store = fmt.Sprintf("/job/%s/credentials/store/folder", cm.Folder)
+	}
+	return fmt.Sprintf("%s/domain/%s", store, domain)
+}
+
+// resourceJenkinsCredentialImport imports any kind of credentials, given as "[<folder>/]<domain>/<name>"
+// or as the URL of the credential in Jenkins.
+func resourceJenkinsCredentialImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{d}
+
+	folders, segments, err := parseImportID(d.Id(), 2)
+	if err != nil {
+		return ret, fmt.Errorf("import ID was improperly formatted (%v). Imports need to be in the format \"[<folder>/]<domain>/<name>\" or the URL of the credential", err)
+	}
+
+	domain, name := segments[0], segments[1]
+	d.Set("name", name)
+	d.Set("domain", domain)
+
+	folder := importFolder(m, folders)
+	d.Set("folder", folder)
+
+	d.SetId(generateCredentialID(folder, name))
+	return ret, nil
+}
+
+// resourceJenkinsCredentialCustomizeDiff rejects SYSTEM scoped credentials within folders, as folder stores do not
+// keep them out of reach of the jobs within the folder, and replaces credentials whose new scope moves them to another store.
+func resourceJenkinsCredentialCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	folder := d.Get("folder").(string)
+	if d.Get("scope").(string) == "SYSTEM" && folder != "" {
+		return fmt.Errorf("credentials with the SYSTEM scope can only be stored in the system store, so \"folder\" may not be set")
+	}
+
+	if d.Id() != "" && d.HasChange("scope") {
+		old, new := d.GetChange("scope")
+		if resolveCredentialsFolder(meta, folder, old.(string)) != resolveCredentialsFolder(meta, folder, new.(string)) {
+			return d.ForceNew("scope")
+		}
+	}
+	return nil
+}
+
+// credentialsCache holds the listing of each credentials domain, fetched the first time a credential within it is read.
+// Once credentials within a domain are written through the provider its listing is stale, so the credentials within
+// it are always fetched individually from then on. All methods are safe to call on a nil cache, which caches nothing.
+type credentialsCache struct {
+	mu       sync.Mutex
+	listings map[string]*credentialsListing
+	changed  map[string]bool
+}
+
+type credentialsListing struct {
+	once        sync.Once
+	credentials map[string]string
+}
+
+func newCredentialsCache() *credentialsCache {
+	return &credentialsCache{listings: map[string]*credentialsListing{}, changed: map[string]bool{}}
+}
+
+// credentialsCacheOf returns the credentials cache of the provider's client, if it has one.
+func credentialsCacheOf(client interface{}) *credentialsCache {
+	if c, ok := client.(interface{ CredentialsCache() *credentialsCache }); ok {
+		return c.CredentialsCache()
+	}
+	return nil
+}
+
+// get looks up the configuration of a credential from the listing of its domain. Credentials are only
+// reported as found if they were part of the listing, leaving missing credentials to be confirmed individually.
+func (c *credentialsCache) get(ctx context.Context, client jenkinsClient, folder, domain, id string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	key := strings.Join(extractFolders(folder), "/") + "/" + domain
+	c.mu.Lock()
+	if c.changed[key] {
+		c.mu.Unlock()
+		return "", false
+	}
+	listing, ok := c.listings[key]
+	if !ok {
+		listing = &credentialsListing{}
+		c.listings[key] = listing
+	}
+	c.mu.Unlock()
+
+	listing.once.Do(func() {
+		input := map[string]string{"folder": strings.Join(extractFolders(folder), "/"), "domain": domain}
+		if err := executeScript(ctx, client, credentialsListScript, input, &listing.credentials); err != nil {
+			// Users without access to the script console can still read credentials individually
+			log.Printf("[DEBUG] jenkins::credentials - Unable to list credentials in %q: %v", key, err)
+		}
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.changed[key] {
+		return "", false
+	}
+	raw, ok := listing.credentials[id]
+	return raw, ok
+}
+
+// forget discards the listing of a domain that credentials are being written to, so that it is no longer served.
+func (c *credentialsCache) forget(folder, domain string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := strings.Join(extractFolders(folder), "/") + "/" + domain
+	c.changed[key] = true
+	delete(c.listings, key)
+}
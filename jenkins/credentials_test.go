@@ -0,0 +1,197 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jenkins "github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/job/team/credentials/store/folder/domain/_/credential/deploy/config.xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`<org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl><id>deploy</id><scope>GLOBAL</scope></org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl>`))
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL})
+	c.credentials = nil
+	cm := &credentialsManager{Folder: "team"}
+
+	cred := jenkins.StringCredentials{}
+	if err := getCredentials(context.Background(), c, cm, "_", "deploy", &cred); err != nil {
+		t.Fatalf("Expected the credentials to be read but received: %s", err)
+	} else if cred.ID != "deploy" || cred.Scope != "GLOBAL" {
+		t.Errorf("Expected the credentials to be decoded but got: %+v", cred)
+	}
+
+	if err := getCredentials(context.Background(), c, cm, "_", "missing", &cred); !isNotFound(err) {
+		t.Errorf("Expected the credentials to be reported as missing, received %v", err)
+	}
+}
+
+func TestGetCredentials_cached(t *testing.T) {
+	scripts, reads := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/scriptText":
+			scripts++
+			_ = r.ParseForm()
+			if strings.Contains(r.Form.Get("script"), "toXML") {
+				t.Errorf("Expected only the fields read back to be listed, not whole credentials: %s", r.Form.Get("script"))
+			}
+			_, _ = w.Write([]byte(`{"result":{"deploy":"<org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl><id>deploy</id><description>listed</description></org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl>","other":"<org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl><id>other</id><description>listed</description></org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl>"}}`))
+		case "/credentials/store/system/domain/_/credential/deploy/config.xml", "/credentials/store/system/domain/_/credential/other/config.xml":
+			reads++
+			if r.Method == http.MethodPost {
+				return
+			}
+			_, _ = w.Write([]byte(`<org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl><id>deploy</id><description>fetched</description></org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL, DisableCrumb: true})
+	cm := &credentialsManager{}
+
+	for i := 0; i < 3; i++ {
+		cred := jenkins.StringCredentials{}
+		if err := getCredentials(context.Background(), c, cm, "_", "deploy", &cred); err != nil {
+			t.Fatalf("Expected the credentials to be read but received: %s", err)
+		} else if cred.Description != "listed" {
+			t.Errorf("Expected the credentials to be served from the listing but got: %+v", cred)
+		}
+	}
+
+	// Credentials that are not listed are confirmed individually
+	if err := getCredentials(context.Background(), c, cm, "_", "missing", &jenkins.StringCredentials{}); !isNotFound(err) {
+		t.Errorf("Expected the credentials to be reported as missing, received %v", err)
+	}
+
+	// Once credentials within the domain are written, none of them are served from the listing any more
+	if err := updateCredentials(context.Background(), c, cm, "_", "deploy", jenkins.StringCredentials{ID: "deploy"}); err != nil {
+		t.Fatalf("Expected the credentials to be updated but received: %s", err)
+	}
+	for _, id := range []string{"deploy", "other"} {
+		cred := jenkins.StringCredentials{}
+		if err := getCredentials(context.Background(), c, cm, "_", id, &cred); err != nil {
+			t.Fatalf("Expected the credentials to be read but received: %s", err)
+		} else if cred.Description != "fetched" {
+			t.Errorf("Expected credentials %q to be fetched again but got: %+v", id, cred)
+		}
+	}
+
+	if scripts != 1 || reads != 3 {
+		t.Errorf("Expected 1 listing and 3 individual requests but received %d and %d", scripts, reads)
+	}
+}
+
+func TestDeleteCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/credentials/store/system/domain/_/credential/deploy/doDelete" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL, DisableCrumb: true})
+	if err := deleteCredentials(context.Background(), c, &credentialsManager{}, "_", "deploy"); err != nil {
+		t.Errorf("Expected the credentials to be deleted but received: %s", err)
+	}
+
+	// Credentials within a folder that no longer exists are already gone
+	cm := &credentialsManager{Folder: "removed"}
+	if err := deleteCredentials(context.Background(), c, cm, "_", "deploy"); !isNotFound(err) {
+		t.Errorf("Expected the credentials to be reported as missing, received %v", err)
+	}
+}
+
+func TestCreateCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		switch {
+		case r.URL.Path == "/job/team/credentials/store/folder/domain/_/createCredentials" && strings.Contains(string(body), "<id>deploy</id>"):
+		case r.URL.Path == "/job/team/credentials/store/folder/domain/_/credential/deploy/config.xml":
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := newJenkinsClient(&Config{ServerURL: server.URL, DisableCrumb: true})
+	cm := &credentialsManager{Folder: "team"}
+
+	cred := jenkins.StringCredentials{ID: "deploy", Scope: "GLOBAL"}
+	if err := createCredentials(context.Background(), c, cm, "_", cred); err != nil {
+		t.Errorf("Expected the credentials to be created but received: %s", err)
+	}
+
+	// Unsuccessful responses carry their status code
+	var status *statusError
+	if err := updateCredentials(context.Background(), c, cm, "_", "deploy", &cred); !errors.As(err, &status) || status.StatusCode != http.StatusConflict {
+		t.Errorf("Expected the conflict to be reported, received %v", err)
+	}
+	if err := updateCredentials(context.Background(), c, cm, "missing", "deploy", &cred); !isNotFound(err) {
+		t.Errorf("Expected the missing domain to be reported, received %v", err)
+	}
+}
+
+func TestPreventDestroyIfInUse(t *testing.T) {
+	usage := `{"result":{"jobs":["team/build","deploy"],"nodes":["agent-1"]}}`
+	client := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if !strings.Contains(script, "deploy-key") || !strings.Contains(script, "team") {
+				return `{"error":"unexpected input"}`, nil
+			}
+			return usage, nil
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceJenkinsCredentialSSH().Schema, map[string]interface{}{
+		"name":                      "deploy-key",
+		"prevent_destroy_if_in_use": true,
+	})
+
+	err := preventDestroyIfInUse(context.Background(), client, d, "team", "_")
+	if err == nil || !strings.Contains(err.Error(), "in use by jobs team/build, deploy and nodes agent-1") {
+		t.Errorf("Expected the jobs and nodes using the credentials to be listed, but got: %v", err)
+	}
+
+	usage = `{"result":{"jobs":[],"nodes":[]}}`
+	if err := preventDestroyIfInUse(context.Background(), client, d, "team", "_"); err != nil {
+		t.Errorf("Expected unused credentials to be deleted, but got: %s", err)
+	}
+
+	// Credentials are deleted without looking up their usage unless asked to
+	d.Set("prevent_destroy_if_in_use", false)
+	client.mockExecuteScript = nil
+	if err := preventDestroyIfInUse(context.Background(), client, d, "team", "_"); err != nil {
+		t.Errorf("Expected the usage not to be checked, but got: %s", err)
+	}
+}
+
+func TestResourceJenkinsCredentialImport(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceJenkinsCredentialSSH().Schema, map[string]interface{}{})
+	d.SetId("https://ci.example.com/job/team/job/builds/credentials/store/folder/domain/_/credential/deploy-key/")
+
+	if _, err := resourceJenkinsCredentialImport(context.Background(), d, &mockJenkinsClient{}); err != nil {
+		t.Fatalf("Expected the import to succeed but received: %s", err)
+	}
+	if d.Get("folder") != "team/job/builds" || d.Get("domain") != "_" || d.Get("name") != "deploy-key" {
+		t.Errorf("Expected the credential to be located but received %q %q %q", d.Get("folder"), d.Get("domain"), d.Get("name"))
+	}
+	if d.Id() != "team/job/builds/deploy-key" {
+		t.Errorf("Expected the ID of the credential but received %q", d.Id())
+	}
+}
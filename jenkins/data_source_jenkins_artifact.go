@@ -0,0 +1,134 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsArtifact() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsArtifactRead,
+		Schema: map[string]*schema.Schema{
+			"job": {
+				Type:             schema.TypeString,
+				Description:      "The name of the job that archived the artifact.",
+				Required:         true,
+				ValidateDiagFunc: validateJobName,
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Description:      "The folder namespace that the job exists in.",
+				Optional:         true,
+				ValidateDiagFunc: validateFolderName,
+			},
+			"build": {
+				Type:             schema.TypeString,
+				Description:      "The build that archived the artifact, either its number or a permalink such as lastStableBuild.",
+				Optional:         true,
+				Default:          "lastSuccessfulBuild",
+				ValidateDiagFunc: validateBuild,
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Description: "The path of the artifact, relative to the artifacts of the build.",
+				Required:    true,
+			},
+			"build_number": {
+				Type:        schema.TypeInt,
+				Description: "The number of the build that archived the artifact.",
+				Computed:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL to download the artifact from.",
+				Computed:    true,
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Description: "The size of the artifact in bytes.",
+				Computed:    true,
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Description: "The MD5 checksum Jenkins recorded for the artifact, if the job fingerprints its artifacts.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsArtifactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	folderName := resolveFolder(meta, d.Get("folder").(string))
+	jobID := formatFolderName(folderName + "/" + d.Get("job").(string))
+	selector := d.Get("build").(string)
+	artifactPath := strings.TrimPrefix(d.Get("path").(string), "/")
+
+	build := struct {
+		Number    int    `json:"number"`
+		URL       string `json:"url"`
+		Artifacts []struct {
+			RelativePath string `json:"relativePath"`
+		} `json:"artifacts"`
+		Fingerprint []struct {
+			FileName string `json:"fileName"`
+			Hash     string `json:"hash"`
+		} `json:"fingerprint"`
+	}{}
+	query := map[string]string{"tree": "number,url,artifacts[relativePath],fingerprint[fileName,hash]"}
+	if err := client.GetJSON(ctx, "/job/"+jobID+"/"+selector, query, &build); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not find build %s of job %q: %w", selector, jobID, describeAuthError(err)))
+	}
+
+	found := false
+	for _, artifact := range build.Artifacts {
+		if artifact.RelativePath == artifactPath {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return diag.Errorf("jenkins::read - Build %d of job %q did not archive an artifact at %q", build.Number, jobID, artifactPath)
+	}
+
+	artifactURL := strings.TrimRight(build.URL, "/") + "/artifact/" + artifactPath
+	header, err := client.Head(ctx, artifactURL, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not determine the size of artifact %q: %w", artifactPath, describeAuthError(err)))
+	}
+	size, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return diag.Errorf("jenkins::read - Jenkins did not report the size of artifact %q", artifactPath)
+	}
+
+	// Fingerprints are recorded under the path of the artifact, or only its name by older versions of Jenkins
+	fingerprint := ""
+	for _, record := range build.Fingerprint {
+		if record.FileName == artifactPath || record.FileName == path.Base(artifactPath) {
+			fingerprint = record.Hash
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d/artifact/%s", jobID, build.Number, artifactPath))
+	if err := d.Set("build_number", build.Number); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", artifactURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("size", int(size)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fingerprint", fingerprint); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
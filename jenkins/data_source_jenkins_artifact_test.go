@@ -0,0 +1,64 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsArtifactDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_artifact missing {
+					job  = "tf-acc-test-missing"
+					path = "manifest.yaml"
+				}`,
+				ExpectError: regexp.MustCompile("Could not find build lastSuccessfulBuild"),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsArtifactRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			if endpoint != "/job/team/job/deploy/lastSuccessfulBuild" {
+				return newStatusError(404, "")
+			}
+			return json.Unmarshal([]byte(`{
+				"number": 12,
+				"url": "https://jenkins.example.com/job/team/job/deploy/12/",
+				"artifacts": [{"relativePath": "out/manifest.yaml"}, {"relativePath": "out/values.yaml"}],
+				"fingerprint": [{"fileName": "out/manifest.yaml", "hash": "d41d8cd98f00b204e9800998ecf8427e"}]
+			}`), v)
+		},
+		mockHead: func(ctx context.Context, endpoint string, query map[string]string) (http.Header, error) {
+			if endpoint != "https://jenkins.example.com/job/team/job/deploy/12/artifact/out/manifest.yaml" {
+				return nil, newStatusError(404, "")
+			}
+			return http.Header{"Content-Length": []string{"1024"}}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsArtifact().Schema, map[string]interface{}{"job": "deploy", "folder": "team", "path": "out/manifest.yaml"})
+	if diags := dataSourceJenkinsArtifactRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the artifact to be found but received %v", diags)
+	}
+	if d.Id() != "team/job/deploy/12/artifact/out/manifest.yaml" || d.Get("build_number") != 12 || d.Get("size") != 1024 || d.Get("fingerprint") != "d41d8cd98f00b204e9800998ecf8427e" {
+		t.Errorf("Expected the artifact to be described but received %s: %v", d.Id(), d.State())
+	}
+
+	// Test if we fail when we should
+	d = schema.TestResourceDataRaw(t, dataSourceJenkinsArtifact().Schema, map[string]interface{}{"job": "deploy", "folder": "team", "path": "out/missing.yaml"})
+	if diags := dataSourceJenkinsArtifactRead(context.Background(), d, meta); !diags.HasError() {
+		t.Error("Expected artifacts that were not archived to be reported")
+	}
+}
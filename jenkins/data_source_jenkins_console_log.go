@@ -0,0 +1,144 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsConsoleLog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsConsoleLogRead,
+		Schema: map[string]*schema.Schema{
+			"job": {
+				Type:             schema.TypeString,
+				Description:      "The name of the job that ran the build.",
+				Required:         true,
+				ValidateDiagFunc: validateJobName,
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Description:      "The folder namespace that the job exists in.",
+				Optional:         true,
+				ValidateDiagFunc: validateFolderName,
+			},
+			"build": {
+				Type:             schema.TypeString,
+				Description:      "The build to read the console output of, either its number or a permalink such as lastCompletedBuild.",
+				Optional:         true,
+				Default:          "lastBuild",
+				ValidateDiagFunc: validateBuild,
+			},
+			"max_bytes": {
+				Type:             schema.TypeInt,
+				Description:      "The most bytes to read from the end of the console output.",
+				Optional:         true,
+				Default:          65536,
+				ValidateDiagFunc: validatePositive,
+			},
+			"build_number": {
+				Type:        schema.TypeInt,
+				Description: "The number of the build that the console output is of.",
+				Computed:    true,
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Description: "The result of the build, such as SUCCESS or FAILURE. Empty while the build is running.",
+				Computed:    true,
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Description: "The end of the console output.",
+				Computed:    true,
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Description: "The size of the whole console output in bytes.",
+				Computed:    true,
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Description: "Whether the beginning of the console output was left out due to max_bytes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsConsoleLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	folderName := resolveFolder(meta, d.Get("folder").(string))
+	jobID := formatFolderName(folderName + "/" + d.Get("job").(string))
+	selector := d.Get("build").(string)
+	maxBytes := int64(d.Get("max_bytes").(int))
+
+	// Permalinks are resolved first, so that a new build starting in between does not mix up the output of two builds
+	build := struct {
+		Number int    `json:"number"`
+		URL    string `json:"url"`
+		Result string `json:"result"`
+	}{}
+	if err := client.GetJSON(ctx, "/job/"+jobID+"/"+selector, map[string]string{"tree": "number,url,result"}, &build); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not find build %s of job %q: %w", selector, jobID, describeAuthError(err)))
+	}
+
+	// Jenkins reports the size of the output without sending it, so that only its end needs to be fetched
+	endpoint := strings.TrimRight(build.URL, "/") + "/logText/progressiveText"
+	header, err := client.Head(ctx, endpoint, map[string]string{"start": "0"})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not read the console output of build %d of job %q: %w", build.Number, jobID, describeAuthError(err)))
+	}
+	size, err := strconv.ParseInt(header.Get("X-Text-Size"), 10, 64)
+	if err != nil {
+		return diag.Errorf("jenkins::read - Jenkins did not report the size of the console output of build %d of job %q", build.Number, jobID)
+	}
+
+	// The output is read from one byte early, so that an end beginning at a whole line is kept whole
+	start := int64(0)
+	if size > maxBytes {
+		start = size - maxBytes - 1
+	}
+	content, err := client.GetText(ctx, endpoint, map[string]string{"start": strconv.FormatInt(start, 10)})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not read the console output of build %d of job %q: %w", build.Number, jobID, describeAuthError(err)))
+	}
+	content, truncated := tailLines(content, int(maxBytes))
+
+	d.SetId(fmt.Sprintf("%s/%d/console", jobID, build.Number))
+	if err := d.Set("build_number", build.Number); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("result", build.Result); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("content", content); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("size", int(size)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("truncated", truncated); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// tailLines keeps at most the given number of bytes from the end of the text, which may have grown since its size was
+// determined. Text that is cut short begins at the next whole line, so that partial lines or characters are left out.
+func tailLines(text string, max int) (string, bool) {
+	if len(text) <= max {
+		return text, false
+	}
+
+	// The byte preceding the part to keep tells whether it begins at a whole line
+	text = text[len(text)-max-1:]
+	if i := strings.Index(text, "\n"); i >= 0 {
+		return text[i+1:], true
+	}
+	return text[1:], true
+}
@@ -0,0 +1,71 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsConsoleLogDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_console_log missing {
+					job = "tf-acc-test-missing"
+				}`,
+				ExpectError: regexp.MustCompile("Could not find build lastBuild"),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsConsoleLogRead(t *testing.T) {
+	log := "Started by user admin\nRunning the seed job\nProcessed 3 jobs\nFinished: SUCCESS\n"
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			if endpoint != "/job/seed/lastBuild" {
+				return newStatusError(404, "")
+			}
+			return json.Unmarshal([]byte(`{"number": 7, "url": "https://jenkins.example.com/job/seed/7/", "result": "SUCCESS"}`), v)
+		},
+		mockHead: func(ctx context.Context, endpoint string, query map[string]string) (http.Header, error) {
+			return http.Header{"X-Text-Size": []string{"78"}}, nil
+		},
+		mockGetText: func(ctx context.Context, endpoint string, query map[string]string) (string, error) {
+			if endpoint != "https://jenkins.example.com/job/seed/7/logText/progressiveText" || query["start"] != "42" {
+				return "", newStatusError(404, "")
+			}
+			return log[42:], nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsConsoleLog().Schema, map[string]interface{}{"job": "seed", "max_bytes": 35})
+	if diags := dataSourceJenkinsConsoleLogRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the console output to be read but received %v", diags)
+	}
+	if d.Id() != "seed/7/console" || d.Get("result") != "SUCCESS" || d.Get("size") != 78 || d.Get("truncated") != true {
+		t.Errorf("Expected the build to be described but received %s: %v", d.Id(), d.State())
+	}
+	if d.Get("content") != "Processed 3 jobs\nFinished: SUCCESS\n" {
+		t.Errorf("Expected the output to start at a whole line but received %q", d.Get("content"))
+	}
+}
+
+func TestTailLines(t *testing.T) {
+	if actual, truncated := tailLines("one\ntwo\n", 100); actual != "one\ntwo\n" || truncated {
+		t.Errorf("Expected short output to be kept whole but received %q", actual)
+	}
+	if actual, truncated := tailLines("one\ntwo\nthree\n", 9); actual != "three\n" || !truncated {
+		t.Errorf("Expected the output to be cut at a whole line but received %q", actual)
+	}
+	if actual, _ := tailLines("one\ntwo\nthree\n", 10); actual != "two\nthree\n" {
+		t.Errorf("Expected a whole line at the cut to be kept but received %q", actual)
+	}
+}
@@ -22,9 +22,10 @@ func dataSourceJenkinsCredentialUsername() *schema.Resource {
 				Optional:    true,
 			},
 			"folder": {
-				Type:        schema.TypeString,
-				Description: "The folder namespace that the credentials will be added to.",
-				Optional:    true,
+				Type:             schema.TypeString,
+				Description:      "The folder namespace that the credentials will be added to.",
+				Optional:         true,
+				ValidateDiagFunc: validateFolderName,
 			},
 			"scope": {
 				Type:        schema.TypeString,
@@ -47,7 +48,7 @@ func dataSourceJenkinsCredentialUsername() *schema.Resource {
 
 func dataSourceJenkinsCredentialUsernameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
-	folderName := d.Get("folder").(string)
+	folderName := resolveFolder(meta, d.Get("folder").(string))
 	d.SetId(formatFolderName(folderName + "/" + name))
 
 	return resourceJenkinsCredentialUsernameRead(ctx, d, meta)
@@ -22,9 +22,10 @@ func dataSourceJenkinsCredentialVaultAppRole() *schema.Resource {
 				Optional:    true,
 			},
 			"folder": {
-				Type:        schema.TypeString,
-				Description: "The folder namespace that the credentials will be added to.",
-				Optional:    true,
+				Type:             schema.TypeString,
+				Description:      "The folder namespace that the credentials will be added to.",
+				Optional:         true,
+				ValidateDiagFunc: validateFolderName,
 			},
 			"scope": {
 				Type:        schema.TypeString,
@@ -52,7 +53,7 @@ func dataSourceJenkinsCredentialVaultAppRole() *schema.Resource {
 
 func dataSourceJenkinsCredentialVaultAppRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
-	folderName := d.Get("folder").(string)
+	folderName := resolveFolder(meta, d.Get("folder").(string))
 	d.SetId(formatFolderName(folderName + "/" + name))
 
 	return resourceJenkinsCredentialVaultAppRoleRead(ctx, d, meta)
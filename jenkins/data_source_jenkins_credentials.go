@@ -0,0 +1,107 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsCredentials() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsCredentialsRead,
+		Schema: map[string]*schema.Schema{
+			"folder": {
+				Type:             schema.TypeString,
+				Description:      "The folder namespace to list the credentials of. The global Jenkins credentials are listed if not set.",
+				Optional:         true,
+				ValidateDiagFunc: validateFolderName,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain namespace to list the credentials of.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name_regex": {
+				Type:             schema.TypeString,
+				Description:      "A regular expression that the identifiers of the listed credentials must match.",
+				Optional:         true,
+				ValidateDiagFunc: validateRegex,
+			},
+			"credentials": {
+				Type:        schema.TypeList,
+				Description: "The credentials within the domain. Their secrets are never included.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The identifier assigned to the credentials.",
+							Computed:    true,
+						},
+						"type_name": {
+							Type:        schema.TypeString,
+							Description: "The kind of credentials, such as \"Username with password\".",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "The name Jenkins displays for the credentials.",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The credentials description.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	cm := &credentialsManager{Folder: formatFolderName(resolveFolder(meta, d.Get("folder").(string)))}
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
+
+	endpoint := credentialsDomainURL(cm, domain)
+
+	credentials := []map[string]interface{}{}
+	err := listItems(ctx, client, endpoint, "credentials", "id,typeName,displayName,description", func(item json.RawMessage) error {
+		cred := struct {
+			ID          string `json:"id"`
+			TypeName    string `json:"typeName"`
+			DisplayName string `json:"displayName"`
+			Description string `json:"description"`
+		}{}
+		if err := json.Unmarshal(item, &cred); err != nil {
+			return err
+		}
+
+		if matchName(d, cred.ID) {
+			credentials = append(credentials, map[string]interface{}{
+				"name":         cred.ID,
+				"type_name":    cred.TypeName,
+				"display_name": cred.DisplayName,
+				"description":  cred.Description,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list credentials in domain %q: %w", domain, describeAuthError(err)))
+	}
+
+	d.SetId(generateCredentialID(cm.Folder, domain))
+	d.Set("domain", domain)
+	if err := d.Set("credentials", credentials); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsCredentialsDataSource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_secret_text foo {
+					name   = "tf-acc-test-%s"
+					secret = "super-secret"
+				}
+
+				data jenkins_credentials foo {
+					name_regex = "^${jenkins_credential_secret_text.foo.name}$"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_credentials.foo", "domain", "_"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.foo", "credentials.#", "1"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.foo", "credentials.0.name", "tf-acc-test-"+randString),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsCredentialsRead(t *testing.T) {
+	endpoint := ""
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, e string, query map[string]string, v interface{}) error {
+			endpoint = e
+			return json.Unmarshal([]byte(`{"credentials": [
+				{"id": "deploy-key", "typeName": "SSH Username with private key", "displayName": "deploy"},
+				{"id": "registry", "typeName": "Username with password", "displayName": "ci/******"}
+			]}`), v)
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsCredentials().Schema, map[string]interface{}{
+		"folder":     "team",
+		"name_regex": "^deploy",
+	})
+	if diags := dataSourceJenkinsCredentialsRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the credentials to be listed but received %v", diags)
+	}
+
+	if endpoint != "/job/team/credentials/store/folder/domain/_" {
+		t.Errorf("Expected the folder's store to be listed but received %q", endpoint)
+	}
+	if d.Get("credentials.#") != 1 || d.Get("credentials.0.type_name") != "SSH Username with private key" {
+		t.Errorf("Expected only the matching credentials but received %v", d.Get("credentials"))
+	}
+}
@@ -61,7 +61,7 @@ func dataSourceJenkinsFolder() *schema.Resource {
 
 func dataSourceJenkinsFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
-	folderName := d.Get("folder").(string)
+	folderName := resolveFolder(meta, d.Get("folder").(string))
 	d.SetId(formatFolderName(folderName + "/" + name))
 
 	return resourceJenkinsFolderRead(ctx, d, meta)
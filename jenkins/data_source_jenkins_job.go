@@ -34,7 +34,7 @@ func dataSourceJenkinsJob() *schema.Resource {
 
 func dataSourceJenkinsJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
-	folderName := d.Get("folder").(string)
+	folderName := resolveFolder(meta, d.Get("folder").(string))
 	d.SetId(formatFolderName(folderName + "/" + name))
 
 	return resourceJenkinsJobRead(ctx, d, meta)
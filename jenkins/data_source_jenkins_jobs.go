@@ -0,0 +1,102 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsJobsRead,
+		Schema: map[string]*schema.Schema{
+			"folder": {
+				Type:             schema.TypeString,
+				Description:      "The folder namespace to list the jobs of. The jobs at the top level of Jenkins are listed if not set.",
+				Optional:         true,
+				ValidateDiagFunc: validateFolderName,
+			},
+			"name_regex": {
+				Type:             schema.TypeString,
+				Description:      "A regular expression that the names of the listed jobs must match.",
+				Optional:         true,
+				ValidateDiagFunc: validateRegex,
+			},
+			"jobs": {
+				Type:        schema.TypeList,
+				Description: "The jobs within the folder, including any subfolders.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the job.",
+							Computed:    true,
+						},
+						"full_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the job including the folders it is in, such as \"parent/child/job-name\".",
+							Computed:    true,
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Description: "The URL of the job.",
+							Computed:    true,
+						},
+						"class": {
+							Type:        schema.TypeString,
+							Description: "The Java class of the job, which tells apart the different kinds of jobs and folders.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	folders := extractFolders(resolveFolder(meta, d.Get("folder").(string)))
+
+	endpoint := "/"
+	if len(folders) > 0 {
+		endpoint = formatFolderID(folders)
+	}
+
+	jobs := []map[string]interface{}{}
+	err := listItems(ctx, client, endpoint, "jobs", "name,fullName,url,_class", func(item json.RawMessage) error {
+		job := struct {
+			Name     string `json:"name"`
+			FullName string `json:"fullName"`
+			URL      string `json:"url"`
+			Class    string `json:"_class"`
+		}{}
+		if err := json.Unmarshal(item, &job); err != nil {
+			return err
+		}
+
+		if matchName(d, job.Name) {
+			jobs = append(jobs, map[string]interface{}{
+				"name":      job.Name,
+				"full_name": job.FullName,
+				"url":       job.URL,
+				"class":     job.Class,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list jobs in %q: %w", endpoint, describeAuthError(err)))
+	}
+
+	d.SetId(fmt.Sprintf("jobs%s", endpoint))
+	if err := d.Set("jobs", jobs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
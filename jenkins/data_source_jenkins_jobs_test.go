@@ -0,0 +1,74 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsJobsDataSource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+					name = "tf-acc-test-%s"
+				}
+
+				resource jenkins_folder sub {
+					name   = "subfolder"
+					folder = jenkins_folder.foo.id
+				}
+
+				data jenkins_jobs foo {
+					folder     = jenkins_folder.foo.id
+					name_regex = "^sub"
+
+					depends_on = [jenkins_folder.sub]
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_jobs.foo", "jobs.#", "1"),
+					resource.TestCheckResourceAttr("data.jenkins_jobs.foo", "jobs.0.name", "subfolder"),
+					resource.TestCheckResourceAttr("data.jenkins_jobs.foo", "jobs.0.full_name", "tf-acc-test-"+randString+"/subfolder"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsJobsRead(t *testing.T) {
+	endpoint := ""
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, e string, query map[string]string, v interface{}) error {
+			endpoint = e
+			return json.Unmarshal([]byte(`{"jobs": [
+				{"_class": "hudson.model.FreeStyleProject", "name": "build", "fullName": "team/build"},
+				{"_class": "com.cloudbees.hudson.plugins.folder.Folder", "name": "archive", "fullName": "team/archive"}
+			]}`), v)
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsJobs().Schema, map[string]interface{}{
+		"folder":     "team",
+		"name_regex": "^b",
+	})
+	if diags := dataSourceJenkinsJobsRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the jobs to be listed but received %v", diags)
+	}
+
+	if endpoint != "/job/team" {
+		t.Errorf("Expected the folder to be listed but received %q", endpoint)
+	}
+	if d.Get("jobs.#") != 1 || d.Get("jobs.0.full_name") != "team/build" || d.Get("jobs.0.class") != "hudson.model.FreeStyleProject" {
+		t.Errorf("Expected only the matching job but received %v", d.Get("jobs"))
+	}
+}
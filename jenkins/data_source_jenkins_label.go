@@ -0,0 +1,154 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsLabel() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsLabelRead,
+		Schema: map[string]*schema.Schema{
+			"expression": {
+				Type:        schema.TypeString,
+				Description: "The label expression to resolve, such as a single label or \"linux && docker\".",
+				Required:    true,
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Description: "The nodes matching the label expression.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the node.",
+							Computed:    true,
+						},
+						"num_executors": {
+							Type:        schema.TypeInt,
+							Description: "The number of builds the node can run at once.",
+							Computed:    true,
+						},
+						"offline": {
+							Type:        schema.TypeBool,
+							Description: "Whether the node is unavailable to run builds.",
+							Computed:    true,
+						},
+						"idle": {
+							Type:        schema.TypeBool,
+							Description: "Whether none of the executors of the node are running builds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"total_executors": {
+				Type:        schema.TypeInt,
+				Description: "The number of executors of the online nodes matching the label expression.",
+				Computed:    true,
+			},
+			"busy_executors": {
+				Type:        schema.TypeInt,
+				Description: "The number of executors matching the label expression that are running builds.",
+				Computed:    true,
+			},
+			"idle_executors": {
+				Type:        schema.TypeInt,
+				Description: "The number of executors matching the label expression that are available for builds.",
+				Computed:    true,
+			},
+			"offline": {
+				Type:        schema.TypeBool,
+				Description: "Whether all nodes matching the label expression are offline, which includes no nodes matching it.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// builtInNodeClass is the type of the computer of the built-in node, whose name is empty in the nodes of a label.
+const builtInNodeClass = "hudson.model.Hudson$MasterComputer"
+
+func dataSourceJenkinsLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	expression := d.Get("expression").(string)
+
+	label := struct {
+		Nodes []struct {
+			NodeName string `json:"nodeName"`
+		} `json:"nodes"`
+		TotalExecutors int  `json:"totalExecutors"`
+		BusyExecutors  int  `json:"busyExecutors"`
+		IdleExecutors  int  `json:"idleExecutors"`
+		Offline        bool `json:"offline"`
+	}{}
+	query := map[string]string{"tree": "nodes[nodeName],totalExecutors,busyExecutors,idleExecutors,offline"}
+	if err := client.GetJSON(ctx, "/label/"+url.PathEscape(expression), query, &label); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not resolve label %q: %w", expression, describeAuthError(err)))
+	}
+
+	// The availability of each node is only known to its computer
+	computers := map[string]map[string]interface{}{}
+	err := listItems(ctx, client, "/computer", "computer", "displayName,numExecutors,offline,idle", func(item json.RawMessage) error {
+		computer := struct {
+			Class        string `json:"_class"`
+			DisplayName  string `json:"displayName"`
+			NumExecutors int    `json:"numExecutors"`
+			Offline      bool   `json:"offline"`
+			Idle         bool   `json:"idle"`
+		}{}
+		if err := json.Unmarshal(item, &computer); err != nil {
+			return err
+		}
+
+		name := computer.DisplayName
+		if computer.Class == builtInNodeClass {
+			name = ""
+		}
+		computers[name] = map[string]interface{}{
+			"name":          computer.DisplayName,
+			"num_executors": computer.NumExecutors,
+			"offline":       computer.Offline,
+			"idle":          computer.Idle,
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list nodes: %w", describeAuthError(err)))
+	}
+
+	nodes := []map[string]interface{}{}
+	for _, node := range label.Nodes {
+		if computer, ok := computers[node.NodeName]; ok {
+			nodes = append(nodes, computer)
+		} else {
+			// Nodes without a computer have no executors, and cannot run builds
+			nodes = append(nodes, map[string]interface{}{"name": node.NodeName, "num_executors": 0, "offline": true, "idle": true})
+		}
+	}
+
+	d.SetId(expression)
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("total_executors", label.TotalExecutors); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("busy_executors", label.BusyExecutors); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("idle_executors", label.IdleExecutors); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("offline", label.Offline); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
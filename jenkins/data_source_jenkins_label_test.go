@@ -0,0 +1,60 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsLabelDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_label built_in {
+					expression = "built-in"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_label.built_in", "id", "built-in"),
+					resource.TestCheckResourceAttr("data.jenkins_label.built_in", "nodes.0.name", "Built-In Node"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsLabelRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			switch endpoint {
+			case "/label/linux%20&&%20docker":
+				return json.Unmarshal([]byte(`{"nodes": [{"nodeName": ""}, {"nodeName": "linux-1"}], "totalExecutors": 2, "busyExecutors": 1, "idleExecutors": 1}`), v)
+			case "/computer":
+				return json.Unmarshal([]byte(`{"computer": [
+					{"_class": "hudson.model.Hudson$MasterComputer", "displayName": "Built-In Node", "numExecutors": 2, "idle": false},
+					{"_class": "hudson.slaves.SlaveComputer", "displayName": "linux-1", "numExecutors": 4, "offline": true, "idle": true},
+					{"_class": "hudson.slaves.SlaveComputer", "displayName": "windows-1", "numExecutors": 1}
+				]}`), v)
+			}
+			return newStatusError(404, "")
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsLabel().Schema, map[string]interface{}{"expression": "linux && docker"})
+	if diags := dataSourceJenkinsLabelRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the label to be resolved but received %v", diags)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"name": "Built-In Node", "num_executors": 2, "offline": false, "idle": false},
+		map[string]interface{}{"name": "linux-1", "num_executors": 4, "offline": true, "idle": true},
+	}
+	if !reflect.DeepEqual(d.Get("nodes"), expected) || d.Get("busy_executors") != 1 || d.Get("offline") != false {
+		t.Errorf("Expected only the matching nodes but received %v", d.Get("nodes"))
+	}
+}
@@ -0,0 +1,122 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsLoadStatistics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsLoadStatisticsRead,
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The label expression to report the load of. The load of the whole controller is reported if not set.",
+				Optional:    true,
+			},
+			"timescale": {
+				Type:             schema.TypeString,
+				Description:      "The timescale the statistics are averaged over, one of sec10, min or hour.",
+				Optional:         true,
+				Default:          "min",
+				ValidateDiagFunc: validateLoadTimescale,
+			},
+			"busy_executors": {
+				Type:        schema.TypeFloat,
+				Description: "The average number of executors running builds.",
+				Computed:    true,
+			},
+			"idle_executors": {
+				Type:        schema.TypeFloat,
+				Description: "The average number of online executors that are not running builds.",
+				Computed:    true,
+			},
+			"available_executors": {
+				Type:        schema.TypeFloat,
+				Description: "The average number of executors that are idle or could be provisioned by clouds.",
+				Computed:    true,
+			},
+			"online_executors": {
+				Type:        schema.TypeFloat,
+				Description: "The average number of executors of online nodes.",
+				Computed:    true,
+			},
+			"connecting_executors": {
+				Type:        schema.TypeFloat,
+				Description: "The average number of executors of nodes that are still connecting.",
+				Computed:    true,
+			},
+			"total_executors": {
+				Type:        schema.TypeFloat,
+				Description: "The average number of executors, including those of offline nodes.",
+				Computed:    true,
+			},
+			"queue_length": {
+				Type:        schema.TypeFloat,
+				Description: "The average number of builds waiting for an executor.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// loadStatistics maps the attributes of the load statistics data source to the time series Jenkins keeps for them.
+var loadStatistics = map[string]string{
+	"busy_executors":       "busyExecutors",
+	"idle_executors":       "idleExecutors",
+	"available_executors":  "availableExecutors",
+	"online_executors":     "onlineExecutors",
+	"connecting_executors": "connectingExecutors",
+	"total_executors":      "totalExecutors",
+	"queue_length":         "queueLength",
+}
+
+func dataSourceJenkinsLoadStatisticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	label := d.Get("label").(string)
+	timescale := d.Get("timescale").(string)
+
+	endpoint := "/overallLoad"
+	if label != "" {
+		endpoint = "/label/" + url.PathEscape(label) + "/loadStatistics"
+	}
+
+	fields := []string{}
+	for _, series := range loadStatistics {
+		fields = append(fields, fmt.Sprintf("%s[%s[latest]]", series, timescale))
+	}
+	sort.Strings(fields)
+
+	statistics := map[string]json.RawMessage{}
+	if err := client.GetJSON(ctx, endpoint, map[string]string{"tree": strings.Join(fields, ",")}, &statistics); err != nil {
+		if label != "" {
+			return diag.FromErr(fmt.Errorf("jenkins::read - Could not read the load of label %q: %w", label, describeAuthError(err)))
+		}
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not read the load of Jenkins: %w", describeAuthError(err)))
+	}
+
+	d.SetId(strings.TrimPrefix(endpoint, "/") + "/" + timescale)
+	for key, series := range loadStatistics {
+		// Series that older versions of Jenkins do not keep are left at zero
+		latest := map[string]struct {
+			Latest float64 `json:"latest"`
+		}{}
+		if raw, ok := statistics[series]; ok {
+			if err := json.Unmarshal(raw, &latest); err != nil {
+				return diag.FromErr(fmt.Errorf("jenkins::read - Could not parse the %s of Jenkins: %w", series, err))
+			}
+		}
+		if err := d.Set(key, latest[timescale].Latest); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsLoadStatisticsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_load_statistics overall {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_load_statistics.overall", "id", "overallLoad/min"),
+					resource.TestCheckResourceAttrSet("data.jenkins_load_statistics.overall", "busy_executors"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsLoadStatisticsRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			if endpoint != "/label/linux%20&&%20docker/loadStatistics" || !strings.Contains(query["tree"], "busyExecutors[hour[latest]]") {
+				return newStatusError(404, "")
+			}
+			return json.Unmarshal([]byte(`{
+				"_class": "hudson.model.Label$1",
+				"busyExecutors": {"hour": {"latest": 1.5}},
+				"queueLength": {"hour": {"latest": 0.25}},
+				"totalExecutors": {"hour": {"latest": 4}}
+			}`), v)
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsLoadStatistics().Schema, map[string]interface{}{"label": "linux && docker", "timescale": "hour"})
+	if diags := dataSourceJenkinsLoadStatisticsRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the load to be read but received %v", diags)
+	}
+
+	if d.Id() != "label/linux%20&&%20docker/loadStatistics/hour" {
+		t.Errorf("Expected the ID to locate the statistics but received %s", d.Id())
+	}
+	if d.Get("busy_executors") != 1.5 || d.Get("queue_length") != 0.25 || d.Get("total_executors") != 4.0 || d.Get("connecting_executors") != 0.0 {
+		t.Errorf("Expected the latest averages but received %v and %v", d.Get("busy_executors"), d.Get("queue_length"))
+	}
+}
@@ -0,0 +1,105 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsNodesRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:             schema.TypeString,
+				Description:      "A regular expression that the names of the listed nodes must match.",
+				Optional:         true,
+				ValidateDiagFunc: validateRegex,
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Description: "The nodes of Jenkins, including the built-in node.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the node.",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels assigned to the node, including its own name.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"num_executors": {
+							Type:        schema.TypeInt,
+							Description: "The number of builds the node can run at once.",
+							Computed:    true,
+						},
+						"offline": {
+							Type:        schema.TypeBool,
+							Description: "Whether the node is unavailable to run builds.",
+							Computed:    true,
+						},
+						"temporarily_offline": {
+							Type:        schema.TypeBool,
+							Description: "Whether the node was deliberately taken offline.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	nodes := []map[string]interface{}{}
+	err := listItems(ctx, client, "/computer", "computer", "displayName,numExecutors,offline,temporarilyOffline,assignedLabels[name]", func(item json.RawMessage) error {
+		node := struct {
+			DisplayName        string `json:"displayName"`
+			NumExecutors       int    `json:"numExecutors"`
+			Offline            bool   `json:"offline"`
+			TemporarilyOffline bool   `json:"temporarilyOffline"`
+			AssignedLabels     []struct {
+				Name string `json:"name"`
+			} `json:"assignedLabels"`
+		}{}
+		if err := json.Unmarshal(item, &node); err != nil {
+			return err
+		}
+		if !matchName(d, node.DisplayName) {
+			return nil
+		}
+
+		labels := []string{}
+		for _, label := range node.AssignedLabels {
+			labels = append(labels, label.Name)
+		}
+		nodes = append(nodes, map[string]interface{}{
+			"name":                node.DisplayName,
+			"labels":              labels,
+			"num_executors":       node.NumExecutors,
+			"offline":             node.Offline,
+			"temporarily_offline": node.TemporarilyOffline,
+		})
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list nodes: %w", describeAuthError(err)))
+	}
+
+	d.SetId("nodes")
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
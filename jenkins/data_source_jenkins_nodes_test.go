@@ -0,0 +1,54 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsNodesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_nodes foo {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_nodes.foo", "id", "nodes"),
+					resource.TestCheckResourceAttr("data.jenkins_nodes.foo", "nodes.0.name", "Built-In Node"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsNodesRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			return json.Unmarshal([]byte(`{"computer": [
+				{"displayName": "Built-In Node", "numExecutors": 2, "assignedLabels": [{"name": "built-in"}]},
+				{"displayName": "linux-1", "numExecutors": 4, "offline": true, "assignedLabels": [{"name": "linux"}, {"name": "linux-1"}]}
+			]}`), v)
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsNodes().Schema, map[string]interface{}{"name_regex": "^linux-"})
+	if diags := dataSourceJenkinsNodesRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the nodes to be listed but received %v", diags)
+	}
+
+	expected := []interface{}{map[string]interface{}{
+		"name":                "linux-1",
+		"labels":              []interface{}{"linux", "linux-1"},
+		"num_executors":       4,
+		"offline":             true,
+		"temporarily_offline": false,
+	}}
+	if !reflect.DeepEqual(d.Get("nodes"), expected) {
+		t.Errorf("Expected only the matching node but received %v", d.Get("nodes"))
+	}
+}
@@ -0,0 +1,146 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsPlugin() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsPluginRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The short name of the plugin.",
+				Required:    true,
+			},
+			"minimum_version": {
+				Type:        schema.TypeString,
+				Description: "The oldest version of the plugin that is accepted. Reading fails if an older version is installed.",
+				Optional:    true,
+			},
+			"long_name": {
+				Type:        schema.TypeString,
+				Description: "The human readable name of the plugin.",
+				Computed:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "The installed version of the plugin.",
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the plugin is enabled.",
+				Computed:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the plugin is currently loaded.",
+				Computed:    true,
+			},
+			"has_update": {
+				Type:        schema.TypeBool,
+				Description: "Whether a newer version of the plugin is available.",
+				Computed:    true,
+			},
+			"pending_restart": {
+				Type:        schema.TypeBool,
+				Description: "Whether changes to the plugin are waiting on a restart to take effect.",
+				Computed:    true,
+			},
+			"dependencies": {
+				Type:        schema.TypeList,
+				Description: "The plugins this plugin depends on.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The short name of the plugin depended on.",
+							Computed:    true,
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Description: "The oldest version of the plugin depended on that is required.",
+							Computed:    true,
+						},
+						"optional": {
+							Type:        schema.TypeBool,
+							Description: "Whether the dependency is optional.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsPluginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Get("name").(string)
+
+	// Plugins cannot be looked up individually through the API, so the plugin is picked from the listing
+	var plugin *installedPlugin
+	dependencies := []map[string]interface{}{}
+	err := listItems(ctx, client, "/pluginManager", "plugins", "shortName,longName,version,enabled,active,hasUpdate,deleted,dependencies[shortName,version,optional]", func(item json.RawMessage) error {
+		entry := struct {
+			installedPlugin
+			Dependencies []struct {
+				ShortName string `json:"shortName"`
+				Version   string `json:"version"`
+				Optional  bool   `json:"optional"`
+			} `json:"dependencies"`
+		}{}
+		if err := json.Unmarshal(item, &entry); err != nil {
+			return err
+		}
+		if entry.ShortName != name {
+			return nil
+		}
+
+		plugin = &entry.installedPlugin
+		for _, dependency := range entry.Dependencies {
+			dependencies = append(dependencies, map[string]interface{}{
+				"name":     dependency.ShortName,
+				"version":  dependency.Version,
+				"optional": dependency.Optional,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list plugins: %w", describeAuthError(err)))
+	} else if plugin == nil {
+		return diag.Errorf("jenkins::read - Plugin %q is not installed", name)
+	}
+
+	if minimum := d.Get("minimum_version").(string); minimum != "" && compareVersions(plugin.Version, minimum) < 0 {
+		return diag.Errorf("jenkins::read - Plugin %q is installed at version %s, which is older than the required %s", name, plugin.Version, minimum)
+	}
+
+	jobs, err := client.GetUpdateCenterJobs(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list plugin installations: %w", err))
+	}
+
+	d.SetId(name)
+	for key, value := range flattenPlugins([]installedPlugin{*plugin}, jobs)[0] {
+		if key == "name" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("dependencies", dependencies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsPluginDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_plugin folder {
+					name = "cloudbees-folder"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_plugin.folder", "id", "cloudbees-folder"),
+					resource.TestCheckResourceAttr("data.jenkins_plugin.folder", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("data.jenkins_plugin.folder", "version"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsPluginRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			return json.Unmarshal([]byte(`{"plugins": [
+				{"shortName": "git-client", "version": "4.6.0", "enabled": true, "active": true},
+				{"shortName": "git", "longName": "Git plugin", "version": "5.2.1", "enabled": true, "active": true,
+				 "dependencies": [{"shortName": "git-client", "version": "4.5.0", "optional": false}, {"shortName": "promoted-builds", "version": "873.v6149db_d64130", "optional": true}]}
+			]}`), v)
+		},
+		mockGetUpdateCenterJobs: func(ctx context.Context) ([]updateCenterJob, error) {
+			return nil, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsPlugin().Schema, map[string]interface{}{"name": "git", "minimum_version": "5.0.0"})
+	if diags := dataSourceJenkinsPluginRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the plugin to be read but received %v", diags)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"name": "git-client", "version": "4.5.0", "optional": false},
+		map[string]interface{}{"name": "promoted-builds", "version": "873.v6149db_d64130", "optional": true},
+	}
+	if d.Id() != "git" || d.Get("version") != "5.2.1" || d.Get("long_name") != "Git plugin" || !reflect.DeepEqual(d.Get("dependencies"), expected) {
+		t.Errorf("Expected the plugin and its dependencies but received %q at %v with %v", d.Id(), d.Get("version"), d.Get("dependencies"))
+	}
+
+	// Test if we fail when we should
+	d = schema.TestResourceDataRaw(t, dataSourceJenkinsPlugin().Schema, map[string]interface{}{"name": "git", "minimum_version": "5.3"})
+	want := diag.Errorf("jenkins::read - Plugin %q is installed at version %s, which is older than the required %s", "git", "5.2.1", "5.3")
+	if diags := dataSourceJenkinsPluginRead(context.Background(), d, meta); !reflect.DeepEqual(diags, want) {
+		t.Errorf("dataSourceJenkinsPluginRead() = %v, want %v", diags, want)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceJenkinsPlugin().Schema, map[string]interface{}{"name": "workflow-job"})
+	want = diag.Errorf("jenkins::read - Plugin %q is not installed", "workflow-job")
+	if diags := dataSourceJenkinsPluginRead(context.Background(), d, meta); !reflect.DeepEqual(diags, want) {
+		t.Errorf("dataSourceJenkinsPluginRead() = %v, want %v", diags, want)
+	}
+}
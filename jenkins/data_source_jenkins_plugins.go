@@ -0,0 +1,124 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsPlugins() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsPluginsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:             schema.TypeString,
+				Description:      "A regular expression that the short names of the listed plugins must match.",
+				Optional:         true,
+				ValidateDiagFunc: validateRegex,
+			},
+			"plugins": {
+				Type:        schema.TypeList,
+				Description: "The plugins installed in Jenkins.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The short name of the plugin.",
+							Computed:    true,
+						},
+						"long_name": {
+							Type:        schema.TypeString,
+							Description: "The human readable name of the plugin.",
+							Computed:    true,
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Description: "The installed version of the plugin.",
+							Computed:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the plugin is enabled.",
+							Computed:    true,
+						},
+						"active": {
+							Type:        schema.TypeBool,
+							Description: "Whether the plugin is currently loaded.",
+							Computed:    true,
+						},
+						"has_update": {
+							Type:        schema.TypeBool,
+							Description: "Whether a newer version of the plugin is available.",
+							Computed:    true,
+						},
+						"pending_restart": {
+							Type:        schema.TypeBool,
+							Description: "Whether changes to the plugin are waiting on a restart to take effect.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsPluginsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	plugins := []installedPlugin{}
+	err := listItems(ctx, client, "/pluginManager", "plugins", "shortName,longName,version,enabled,active,hasUpdate,deleted", func(item json.RawMessage) error {
+		plugin := installedPlugin{}
+		if err := json.Unmarshal(item, &plugin); err != nil {
+			return err
+		}
+		if matchName(d, plugin.ShortName) {
+			plugins = append(plugins, plugin)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list plugins: %w", err))
+	}
+
+	jobs, err := client.GetUpdateCenterJobs(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list plugin installations: %w", err))
+	}
+
+	d.SetId("plugins")
+	if err := d.Set("plugins", flattenPlugins(plugins, jobs)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenPlugins(plugins []installedPlugin, jobs []updateCenterJob) []map[string]interface{} {
+	// Installations and upgrades awaiting a restart are only visible through the update center
+	pending := map[string]bool{}
+	for _, job := range jobs {
+		if job.Type == "InstallationJob" {
+			pending[job.Name] = job.Status.Type == "SuccessButRequiresRestart"
+		}
+	}
+
+	ret := []map[string]interface{}{}
+	for _, plugin := range plugins {
+		ret = append(ret, map[string]interface{}{
+			"name":            plugin.ShortName,
+			"long_name":       plugin.LongName,
+			"version":         plugin.Version,
+			"enabled":         plugin.Enabled,
+			"active":          plugin.Active,
+			"has_update":      plugin.HasUpdate,
+			"pending_restart": pending[plugin.ShortName] || plugin.Deleted || plugin.Active != plugin.Enabled,
+		})
+	}
+
+	return ret
+}
@@ -0,0 +1,63 @@
+package jenkins
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJenkinsPluginsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_plugins foo {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_plugins.foo", "id", "plugins"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.jenkins_plugins.foo", "plugins.*", map[string]string{
+						"name":    "cloudbees-folder",
+						"enabled": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func Test_flattenPlugins(t *testing.T) {
+	plugins := []installedPlugin{
+		{ShortName: "git", LongName: "Git plugin", Version: "4.7.1", Enabled: true, Active: true},
+		{ShortName: "matrix-auth", Version: "2.6.6", Enabled: false, Active: true},
+	}
+
+	job := updateCenterJob{Type: "InstallationJob", Name: "git"}
+	job.Status.Type = "SuccessButRequiresRestart"
+
+	got := flattenPlugins(plugins, []updateCenterJob{job})
+	want := []map[string]interface{}{
+		{
+			"name":            "git",
+			"long_name":       "Git plugin",
+			"version":         "4.7.1",
+			"enabled":         true,
+			"active":          true,
+			"has_update":      false,
+			"pending_restart": true,
+		},
+		{
+			"name":            "matrix-auth",
+			"long_name":       "",
+			"version":         "2.6.6",
+			"enabled":         false,
+			"active":          true,
+			"has_update":      false,
+			"pending_restart": true,
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenPlugins() = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,118 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsQueue() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsQueueRead,
+		Schema: map[string]*schema.Schema{
+			"length": {
+				Type:        schema.TypeInt,
+				Description: "The number of items waiting in the build queue.",
+				Computed:    true,
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "The items waiting in the build queue, oldest first.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the queue item.",
+							Computed:    true,
+						},
+						"task_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the job the item will build.",
+							Computed:    true,
+						},
+						"task_url": {
+							Type:        schema.TypeString,
+							Description: "The URL of the job the item will build.",
+							Computed:    true,
+						},
+						"why": {
+							Type:        schema.TypeString,
+							Description: "Why the item is still waiting.",
+							Computed:    true,
+						},
+						"blocked": {
+							Type:        schema.TypeBool,
+							Description: "Whether the item is blocked from running, such as by another build of the same job.",
+							Computed:    true,
+						},
+						"buildable": {
+							Type:        schema.TypeBool,
+							Description: "Whether the item is only waiting for an executor to become available.",
+							Computed:    true,
+						},
+						"stuck": {
+							Type:        schema.TypeBool,
+							Description: "Whether the item has been waiting for an executor for much longer than expected.",
+							Computed:    true,
+						},
+						"in_queue_since": {
+							Type:        schema.TypeInt,
+							Description: "When the item entered the queue, in milliseconds since the epoch.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	queue := struct {
+		Items []struct {
+			ID           int    `json:"id"`
+			Why          string `json:"why"`
+			Blocked      bool   `json:"blocked"`
+			Buildable    bool   `json:"buildable"`
+			Stuck        bool   `json:"stuck"`
+			InQueueSince int    `json:"inQueueSince"`
+			Task         struct {
+				Name string `json:"name"`
+				URL  string `json:"url"`
+			} `json:"task"`
+		} `json:"items"`
+	}{}
+	query := map[string]string{"tree": "items[id,why,blocked,buildable,stuck,inQueueSince,task[name,url]]"}
+	if err := client.GetJSON(ctx, "/queue", query, &queue); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not read the build queue: %w", describeAuthError(err)))
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range queue.Items {
+		items = append(items, map[string]interface{}{
+			"id":             item.ID,
+			"task_name":      item.Task.Name,
+			"task_url":       item.Task.URL,
+			"why":            item.Why,
+			"blocked":        item.Blocked,
+			"buildable":      item.Buildable,
+			"stuck":          item.Stuck,
+			"in_queue_since": item.InQueueSince,
+		})
+	}
+
+	d.SetId("queue")
+	if err := d.Set("length", len(items)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("items", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
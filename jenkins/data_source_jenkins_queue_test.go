@@ -0,0 +1,60 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsQueueDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_queue current {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_queue.current", "id", "queue"),
+					resource.TestCheckResourceAttr("data.jenkins_queue.current", "length", "0"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsQueueRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			if endpoint != "/queue" {
+				return newStatusError(404, "")
+			}
+			return json.Unmarshal([]byte(`{"items": [
+				{"id": 42, "why": "Waiting for next available executor", "buildable": true, "stuck": true, "inQueueSince": 1700000000000,
+				 "task": {"name": "deploy", "url": "http://jenkins/job/deploy/"}}
+			]}`), v)
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsQueue().Schema, map[string]interface{}{})
+	if diags := dataSourceJenkinsQueueRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the queue to be read but received %v", diags)
+	}
+
+	expected := []interface{}{map[string]interface{}{
+		"id":             42,
+		"task_name":      "deploy",
+		"task_url":       "http://jenkins/job/deploy/",
+		"why":            "Waiting for next available executor",
+		"blocked":        false,
+		"buildable":      true,
+		"stuck":          true,
+		"in_queue_since": 1700000000000,
+	}}
+	if d.Get("length") != 1 || !reflect.DeepEqual(d.Get("items"), expected) {
+		t.Errorf("Expected the queued item but received %v", d.Get("items"))
+	}
+}
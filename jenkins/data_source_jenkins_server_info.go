@@ -0,0 +1,90 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsServerInfo() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsServerInfoRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Description: "The version of Jenkins.",
+				Computed:    true,
+			},
+			"mode": {
+				Type:        schema.TypeString,
+				Description: "How the built-in node is used for builds, either NORMAL or EXCLUSIVE.",
+				Computed:    true,
+			},
+			"quieting_down": {
+				Type:        schema.TypeBool,
+				Description: "Whether Jenkins is quieting down, no longer starting new builds.",
+				Computed:    true,
+			},
+			"node_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the built-in node.",
+				Computed:    true,
+			},
+			"num_executors": {
+				Type:        schema.TypeInt,
+				Description: "The number of executors of the built-in node.",
+				Computed:    true,
+			},
+			"restart_required": {
+				Type:        schema.TypeBool,
+				Description: "Whether Jenkins needs to be restarted to complete changes such as plugin installations.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsServerInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	version, err := client.ServerVersion(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not determine the Jenkins version: %w", describeAuthError(err)))
+	}
+
+	info, err := client.ServerInfo(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not read server information: %w", describeAuthError(err)))
+	}
+
+	updateCenter := struct {
+		RestartRequired bool `json:"restartRequiredForCompletion"`
+	}{}
+	if err := client.GetJSON(ctx, "/updateCenter", map[string]string{"tree": "restartRequiredForCompletion"}, &updateCenter); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not determine whether a restart is required: %w", describeAuthError(err)))
+	}
+
+	d.SetId("server_info")
+	if err := d.Set("version", version); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mode", info.Mode); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("quieting_down", info.QuietingDown); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("node_name", info.NodeName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("num_executors", int(info.NumExecutors)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("restart_required", updateCenter.RestartRequired); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
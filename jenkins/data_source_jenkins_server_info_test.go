@@ -0,0 +1,68 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsServerInfoDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_server_info current {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jenkins_server_info.current", "version"),
+					resource.TestCheckResourceAttr("data.jenkins_server_info.current", "mode", "NORMAL"),
+					resource.TestCheckResourceAttr("data.jenkins_server_info.current", "quieting_down", "false"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsServerInfoRead(t *testing.T) {
+	client := &mockJenkinsClient{
+		mockServerVersion: func(ctx context.Context) (string, error) {
+			return "2.426.1", nil
+		},
+		mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+			return &serverInfo{Mode: "NORMAL", NodeName: "", NumExecutors: 2, QuietingDown: true}, nil
+		},
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			if endpoint != "/updateCenter" {
+				return newStatusError(404, "")
+			}
+			return json.Unmarshal([]byte(`{"restartRequiredForCompletion": true}`), v)
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsServerInfo().Schema, map[string]interface{}{})
+	if diags := dataSourceJenkinsServerInfoRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Expected the server information to be read, but got %v", diags)
+	}
+
+	expected := map[string]interface{}{"version": "2.426.1", "mode": "NORMAL", "quieting_down": true, "num_executors": 2, "restart_required": true}
+	for key, value := range expected {
+		if actual := d.Get(key); actual != value {
+			t.Errorf("Expected %s to be %v, but got %v", key, value, actual)
+		}
+	}
+
+	// Test if we fail when we should
+	client.mockServerVersion = func(ctx context.Context) (string, error) {
+		return "", fmt.Errorf("omg")
+	}
+	want := diag.Diagnostics{diag.Diagnostic{Summary: "jenkins::read - Could not determine the Jenkins version: omg"}}
+	if diags := dataSourceJenkinsServerInfoRead(context.Background(), d, client); !reflect.DeepEqual(diags, want) {
+		t.Errorf("dataSourceJenkinsServerInfoRead() = %v, want %v", diags, want)
+	}
+}
@@ -0,0 +1,114 @@
+package jenkins
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func dataSourceJenkinsSSHHostKey() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsSSHHostKeyRead,
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The host name clients reach the SSH daemon at, as advertised by Jenkins.",
+				Computed:    true,
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Description: "The port the SSH daemon listens on.",
+				Computed:    true,
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "The host key of the SSH daemon in the OpenSSH authorized_keys format.",
+				Computed:    true,
+			},
+			"fingerprint_sha256": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 fingerprint of the host key, as shown by OpenSSH.",
+				Computed:    true,
+			},
+			"known_hosts": {
+				Type:        schema.TypeString,
+				Description: "A line for the known_hosts file of SSH clients, trusting the host key for the advertised host and port.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsSSHHostKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// The SSH daemon signs with the instance identity of Jenkins, which every response carries alongside the daemon's endpoint
+	header, err := client.Head(ctx, "/", nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not read the SSH host key: %w", describeAuthError(err)))
+	}
+
+	endpoint := header.Get("X-SSH-Endpoint")
+	if endpoint == "" {
+		return diag.Errorf("jenkins::read - Jenkins did not advertise an SSH endpoint. The SSH daemon may be disabled, which can be changed through the jenkins_sshd resource")
+	}
+	host, rawPort, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not parse the SSH endpoint %q: %w", endpoint, err))
+	}
+	port, err := strconv.Atoi(rawPort)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not parse the SSH endpoint %q: %w", endpoint, err))
+	}
+
+	key, err := parseInstanceIdentity(header.Get("X-Instance-Identity"))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not parse the SSH host key: %w", err))
+	}
+
+	d.SetId(endpoint)
+	if err := d.Set("host", host); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("port", port); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("public_key", strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fingerprint_sha256", ssh.FingerprintSHA256(key)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("known_hosts", knownhosts.Line([]string{knownhosts.Normalize(endpoint)}, key)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// parseInstanceIdentity decodes the public key Jenkins identifies itself with, which the X-Instance-Identity
+// header carries as a base64 encoded X.509 SubjectPublicKeyInfo structure.
+func parseInstanceIdentity(identity string) (ssh.PublicKey, error) {
+	if identity == "" {
+		return nil, fmt.Errorf("Jenkins did not report its instance identity")
+	}
+
+	der, err := base64.StdEncoding.DecodeString(identity)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	return ssh.NewPublicKey(pub)
+}
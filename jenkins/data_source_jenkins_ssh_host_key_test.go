@@ -0,0 +1,74 @@
+package jenkins
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestAccJenkinsSSHHostKeyDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource jenkins_sshd fixed {
+					mode = "fixed"
+					port = 2222
+				}
+
+				data jenkins_ssh_host_key jenkins {
+					depends_on = [jenkins_sshd.fixed]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_ssh_host_key.jenkins", "port", "2222"),
+					resource.TestCheckResourceAttrSet("data.jenkins_ssh_host_key.jenkins", "fingerprint_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsSSHHostKeyRead(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	expected, _ := ssh.NewPublicKey(&private.PublicKey)
+
+	header := http.Header{}
+	header.Set("X-Instance-Identity", base64.StdEncoding.EncodeToString(der))
+	header.Set("X-SSH-Endpoint", "jenkins.example.com:2222")
+	meta := &mockJenkinsClient{
+		mockHead: func(ctx context.Context, endpoint string, query map[string]string) (http.Header, error) {
+			return header, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsSSHHostKey().Schema, map[string]interface{}{})
+	if diags := dataSourceJenkinsSSHHostKeyRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the host key to be read but received %v", diags)
+	}
+	if d.Get("host") != "jenkins.example.com" || d.Get("port") != 2222 || d.Get("fingerprint_sha256") != ssh.FingerprintSHA256(expected) {
+		t.Errorf("Expected the endpoint and key to be described but received %v", d.State())
+	}
+	if !strings.HasPrefix(d.Get("known_hosts").(string), "[jenkins.example.com]:2222 ssh-rsa ") {
+		t.Errorf("Expected a known_hosts line for the endpoint but received %q", d.Get("known_hosts"))
+	}
+
+	// Test if we fail when we should
+	header.Del("X-SSH-Endpoint")
+	if diags := dataSourceJenkinsSSHHostKeyRead(context.Background(), d, meta); !diags.HasError() {
+		t.Error("Expected a disabled SSH daemon to be reported")
+	}
+}
@@ -0,0 +1,163 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsUpdateCenter() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsUpdateCenterRead,
+		Schema: map[string]*schema.Schema{
+			"site": {
+				Type:        schema.TypeString,
+				Description: "The ID of the update site to list plugins from.",
+				Optional:    true,
+				Default:     "default",
+			},
+			"name_regex": {
+				Type:             schema.TypeString,
+				Description:      "A regular expression that the short names of the listed plugins must match.",
+				Optional:         true,
+				ValidateDiagFunc: validateRegex,
+			},
+			"plugins": {
+				Type:        schema.TypeList,
+				Description: "The plugins offered by the update site that are not installed or have an update.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The short name of the plugin.",
+							Computed:    true,
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Description: "The human readable name of the plugin.",
+							Computed:    true,
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Description: "The version of the plugin offered by the update site.",
+							Computed:    true,
+						},
+						"installed_version": {
+							Type:        schema.TypeString,
+							Description: "The installed version of the plugin, if any.",
+							Computed:    true,
+						},
+						"required_core": {
+							Type:        schema.TypeString,
+							Description: "The oldest version of Jenkins the offered version runs on.",
+							Computed:    true,
+						},
+						"compatible": {
+							Type:        schema.TypeBool,
+							Description: "Whether the offered version runs on the version of Jenkins the controller is at.",
+							Computed:    true,
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Description: "The URL to download the offered version from.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"versions": {
+				Type:        schema.TypeMap,
+				Description: "The offered version of each listed plugin that is compatible with the controller, keyed by its short name.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// updateSitePlugin describes a plugin version offered by an update site.
+type updateSitePlugin struct {
+	Name         string `json:"name"`
+	Title        string `json:"title"`
+	Version      string `json:"version"`
+	RequiredCore string `json:"requiredCore"`
+	URL          string `json:"url"`
+}
+
+func dataSourceJenkinsUpdateCenterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	site := d.Get("site").(string)
+
+	// Update sites only list the plugins that are not installed as available, and those that are as updates
+	offered := struct {
+		Availables []updateSitePlugin `json:"availables"`
+		Updates    []updateSitePlugin `json:"updates"`
+	}{}
+	fields := "name,title,version,requiredCore,url"
+	query := map[string]string{"tree": fmt.Sprintf("availables[%s],updates[%s]", fields, fields)}
+	if err := client.GetJSON(ctx, "/updateCenter/site/"+url.PathEscape(site), query, &offered); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list the plugins offered by update site %q: %w", site, describeAuthError(err)))
+	}
+
+	installed := map[string]string{}
+	err := listItems(ctx, client, "/pluginManager", "plugins", "shortName,version", func(item json.RawMessage) error {
+		plugin := struct {
+			ShortName string `json:"shortName"`
+			Version   string `json:"version"`
+		}{}
+		if err := json.Unmarshal(item, &plugin); err != nil {
+			return err
+		}
+		installed[plugin.ShortName] = plugin.Version
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list plugins: %w", err))
+	}
+
+	core, err := client.ServerVersion(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not determine the version of Jenkins: %w", err))
+	}
+
+	plugins := append(offered.Availables, offered.Updates...)
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+
+	flattened := []map[string]interface{}{}
+	versions := map[string]string{}
+	for _, plugin := range plugins {
+		if !matchName(d, plugin.Name) {
+			continue
+		}
+
+		compatible := core == "" || plugin.RequiredCore == "" || compareVersions(plugin.RequiredCore, core) <= 0
+		flattened = append(flattened, map[string]interface{}{
+			"name":              plugin.Name,
+			"title":             plugin.Title,
+			"version":           plugin.Version,
+			"installed_version": installed[plugin.Name],
+			"required_core":     plugin.RequiredCore,
+			"compatible":        compatible,
+			"url":               plugin.URL,
+		})
+		if compatible {
+			versions[plugin.Name] = plugin.Version
+		}
+	}
+
+	d.SetId("update_center/" + site)
+	if err := d.Set("plugins", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("versions", versions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
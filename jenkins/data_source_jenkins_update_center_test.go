@@ -0,0 +1,66 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsUpdateCenterDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_update_center default {
+					name_regex = "^job-dsl$"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_update_center.default", "id", "update_center/default"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsUpdateCenterRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			switch endpoint {
+			case "/updateCenter/site/default":
+				return json.Unmarshal([]byte(`{
+					"availables": [
+						{"name": "job-dsl", "title": "Job DSL", "version": "1.87", "requiredCore": "2.361.4", "url": "https://updates.jenkins.io/download/plugins/job-dsl/1.87/job-dsl.hpi"},
+						{"name": "blueocean", "title": "Blue Ocean", "version": "1.27.9", "requiredCore": "2.479.1"}
+					],
+					"updates": [
+						{"name": "git", "title": "Git", "version": "5.2.1", "requiredCore": "2.401.3"}
+					]
+				}`), v)
+			case "/pluginManager":
+				return json.Unmarshal([]byte(`{"plugins": [{"shortName": "git", "version": "5.0.0"}]}`), v)
+			}
+			return newStatusError(404, "")
+		},
+		mockServerVersion: func(ctx context.Context) (string, error) {
+			return "2.401.3", nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceJenkinsUpdateCenter().Schema, map[string]interface{}{})
+	if diags := dataSourceJenkinsUpdateCenterRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Expected the offered plugins to be listed but received %v", diags)
+	}
+
+	expected := map[string]interface{}{"git": "5.2.1", "job-dsl": "1.87"}
+	if !reflect.DeepEqual(d.Get("versions"), expected) {
+		t.Errorf("Expected only the compatible versions but received %v", d.Get("versions"))
+	}
+	if d.Get("plugins.0.name") != "blueocean" || d.Get("plugins.0.compatible") != false || d.Get("plugins.1.installed_version") != "5.0.0" {
+		t.Errorf("Expected the plugins to be described but received %v", d.Get("plugins"))
+	}
+}
@@ -0,0 +1,82 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Unlike the jenkins_user resource, this works with any security realm, so the user's authorities
+// are resolved through the realm rather than the Jenkins user database.
+const userDataSourceReadScript = `
+	def user = hudson.model.User.getById(input.username, false)
+	if (user == null) {
+		return null
+	}
+	def email = ''
+	try {
+		def mailer = jenkins.model.Jenkins.get().pluginManager.uberClassLoader.loadClass('hudson.tasks.Mailer$UserProperty')
+		email = user.getProperty(mailer)?.address ?: ''
+	} catch (ClassNotFoundException e) {
+	}
+	return [
+		full_name:   user.fullName,
+		email:       email,
+		description: user.description ?: '',
+		authorities: user.authorities,
+	]
+`
+
+func dataSourceJenkinsUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsUserRead,
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The ID of the user.",
+				Required:    true,
+			},
+			"full_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of the user.",
+				Computed:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address of the user.",
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the user.",
+				Computed:    true,
+			},
+			"authorities": {
+				Type:        schema.TypeList,
+				Description: "The groups granted to the user by the security realm.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	username := d.Get("username").(string)
+
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"username": username}
+	if err := executeScript(ctx, client, userDataSourceReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading user %q: %w", username, err))
+	}
+
+	if len(output) == 0 {
+		return diag.Errorf("jenkins::read - User %q does not exist", username)
+	}
+
+	d.SetId(username)
+	return setScriptOutput(d, output)
+}
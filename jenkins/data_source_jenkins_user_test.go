@@ -0,0 +1,92 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsUserDataSource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_user foo {
+				  username  = "tf-acc-test-%s"
+				  full_name = "Terraform acceptance tests"
+				}
+
+				data jenkins_user foo {
+				  username = jenkins_user.foo.username
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_user.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_user.foo", "full_name", "Terraform acceptance tests"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsUserRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name            string
+		args            args
+		want            diag.Diagnostics
+		wantAuthorities int
+	}{
+		{
+			name: "exists",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"full_name":"Administrator","email":"admin@example.com","description":"","authorities":["authenticated","admins"]}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, dataSourceJenkinsUser().Schema, map[string]interface{}{
+					"username": "admin",
+				}),
+			},
+			wantAuthorities: 2,
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, dataSourceJenkinsUser().Schema, map[string]interface{}{
+					"username": "ghost",
+				}),
+			},
+			want: diag.Errorf("jenkins::read - User %q does not exist", "ghost"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dataSourceJenkinsUserRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dataSourceJenkinsUserRead() = %v, want %v", got, tt.want)
+			}
+			if got := len(tt.args.d.Get("authorities").([]interface{})); got != tt.wantAuthorities {
+				t.Errorf("dataSourceJenkinsUserRead() authorities = %v, want %v", got, tt.wantAuthorities)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceJenkinsWhoAmI() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJenkinsWhoAmIRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the user the provider is authenticated as.",
+				Computed:    true,
+			},
+			"authenticated": {
+				Type:        schema.TypeBool,
+				Description: "Whether the provider is authenticated.",
+				Computed:    true,
+			},
+			"anonymous": {
+				Type:        schema.TypeBool,
+				Description: "Whether the provider is treated as the anonymous user.",
+				Computed:    true,
+			},
+			"authorities": {
+				Type:        schema.TypeList,
+				Description: "The authorities granted to the user.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceJenkinsWhoAmIRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	identity, err := client.WhoAmI(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not determine the authenticated user: %w", err))
+	}
+
+	d.SetId(identity.Name)
+	if err := d.Set("name", identity.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("authenticated", identity.Authenticated); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("anonymous", identity.Anonymous); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("authorities", identity.Authorities); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
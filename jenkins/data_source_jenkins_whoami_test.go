@@ -0,0 +1,81 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsWhoAmIDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data jenkins_whoami current {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_whoami.current", "id", "admin"),
+					resource.TestCheckResourceAttr("data.jenkins_whoami.current", "name", "admin"),
+					resource.TestCheckResourceAttr("data.jenkins_whoami.current", "authenticated", "true"),
+					resource.TestCheckResourceAttr("data.jenkins_whoami.current", "anonymous", "false"),
+				),
+			},
+		},
+	})
+}
+
+func Test_dataSourceJenkinsWhoAmIRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   diag.Diagnostics
+		wantID string
+	}{
+		{
+			name: "authenticated",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockWhoAmI: func(ctx context.Context) (*whoAmI, error) {
+						return &whoAmI{Name: "admin", Authenticated: true, Authorities: []string{"authenticated"}}, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, dataSourceJenkinsWhoAmI().Schema, map[string]interface{}{}),
+			},
+			wantID: "admin",
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockWhoAmI: func(ctx context.Context) (*whoAmI, error) {
+						return nil, fmt.Errorf("401 Unauthorized")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, dataSourceJenkinsWhoAmI().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::read - Could not determine the authenticated user: 401 Unauthorized"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dataSourceJenkinsWhoAmIRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dataSourceJenkinsWhoAmIRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("dataSourceJenkinsWhoAmIRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package jenkins
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Plugins that resources depend on regardless of their configuration.
+const (
+	credentialsPlugin     = "credentials"
+	folderPlugin          = "cloudbees-folder"
+	plainCredentialPlugin = "plain-credentials"
+	sshCredentialPlugin   = "ssh-credentials"
+	vaultCredentialPlugin = "hashicorp-vault-plugin"
+)
+
+// requirePlugins ensures that the given plugins are installed and active before a resource depending on them
+// is applied, as Jenkins would otherwise reject its configuration with an error naming an unknown class.
+// The check is skipped for users that are not permitted to list plugins.
+func requirePlugins(ctx context.Context, client jenkinsClient, names ...string) error {
+	cache := pluginCacheOf(client)
+	if cache.installed(names) {
+		return nil
+	}
+
+	plugins, err := client.GetPlugins(ctx, 1)
+	if err != nil {
+		log.Printf("[DEBUG] jenkins::plugins - Unable to determine installed plugins: %v", err)
+		return nil
+	}
+
+	missing := []string{}
+	for _, name := range names {
+		if plugin := plugins.Contains(name); plugin == nil || !plugin.Active {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the following plugins are required but not installed or not active: %s", strings.Join(missing, ", "))
+	}
+
+	cache.add(names)
+	return nil
+}
+
+// xmlPlugins lists the plugins that the elements of a configuration document come from,
+// as recorded by Jenkins in their "plugin" attributes, such as plugin="workflow-job@1254.v3f64639b_11dd".
+// Documents that cannot be parsed only yield the plugins found up to the point of failure.
+func xmlPlugins(config string) []string {
+	found := map[string]bool{}
+	decoder := xml.NewDecoder(bytes.NewReader(handleXml(config)))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Printf("[DEBUG] jenkins::plugins - Unable to parse configuration for plugins: %v", err)
+			break
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			for _, attr := range start.Attr {
+				if attr.Name.Local == "plugin" && attr.Value != "" {
+					found[strings.SplitN(attr.Value, "@", 2)[0]] = true
+				}
+			}
+		}
+	}
+
+	ret := []string{}
+	for name := range found {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// pluginCache remembers the plugins found to be installed, so that applying many resources depending on
+// the same plugins does not list them again for each of them. Missing plugins are never cached, as they
+// may be installed while applying.
+// All methods are safe to call on a nil cache, which caches nothing.
+type pluginCache struct {
+	mu        sync.Mutex
+	available map[string]bool
+}
+
+func newPluginCache() *pluginCache {
+	return &pluginCache{available: map[string]bool{}}
+}
+
+// pluginCacheOf returns the plugin cache of the provider's client, if it has one.
+func pluginCacheOf(client interface{}) *pluginCache {
+	if c, ok := client.(interface{ PluginCache() *pluginCache }); ok {
+		return c.PluginCache()
+	}
+	return nil
+}
+
+func (c *pluginCache) installed(names []string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, name := range names {
+		if !c.available[name] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *pluginCache) add(names []string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, name := range names {
+		c.available[name] = true
+	}
+}
@@ -0,0 +1,76 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRequirePlugins(t *testing.T) {
+	client := &mockJenkinsClient{
+		mockGetPlugins: func(ctx context.Context, depth int) (installedPlugins, error) {
+			return installedPlugins{
+				{ShortName: "cloudbees-folder", Active: true},
+				{ShortName: "ssh-credentials", Active: false},
+			}, nil
+		},
+	}
+
+	if err := requirePlugins(context.Background(), client, folderPlugin); err != nil {
+		t.Errorf("Expected the installed plugin to be accepted, but got: %s", err)
+	}
+
+	// Test if we fail when we should
+	err := requirePlugins(context.Background(), client, folderPlugin, sshCredentialPlugin, "workflow-job")
+	if err == nil || err.Error() != "the following plugins are required but not installed or not active: ssh-credentials, workflow-job" {
+		t.Errorf("Expected the missing plugins to be named, but got: %v", err)
+	}
+
+	// Users that cannot list plugins are left to Jenkins to reject their configuration
+	client.mockGetPlugins = func(ctx context.Context, depth int) (installedPlugins, error) {
+		return nil, errors.New("403 Forbidden")
+	}
+	if err := requirePlugins(context.Background(), client, "workflow-job"); err != nil {
+		t.Errorf("Expected the check to be skipped, but got: %s", err)
+	}
+}
+
+func TestPluginCache(t *testing.T) {
+	cache := newPluginCache()
+	if cache.installed([]string{folderPlugin}) {
+		t.Error("Expected an empty cache to know of no plugins")
+	}
+
+	cache.add([]string{folderPlugin, credentialsPlugin})
+	if !cache.installed([]string{folderPlugin}) || cache.installed([]string{folderPlugin, sshCredentialPlugin}) {
+		t.Error("Expected only the added plugins to be known")
+	}
+
+	var disabled *pluginCache
+	disabled.add([]string{folderPlugin})
+	if disabled.installed([]string{folderPlugin}) {
+		t.Error("Expected a nil cache to cache nothing")
+	}
+}
+
+func TestXMLPlugins(t *testing.T) {
+	config := `<?xml version='1.1' encoding='UTF-8'?>
+<flow-definition plugin="workflow-job@1254.v3f64639b_11dd">
+  <definition class="org.jenkinsci.plugins.workflow.cps.CpsFlowDefinition" plugin="workflow-cps@3653.v07ea_433c90b_4">
+    <script>echo 'hello'</script>
+  </definition>
+  <properties>
+    <org.jenkinsci.plugins.workflow.job.properties.DisableConcurrentBuildsJobProperty plugin="workflow-job"/>
+  </properties>
+</flow-definition>`
+
+	expected := []string{"workflow-cps", "workflow-job"}
+	if actual := xmlPlugins(config); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v to be required, but got %v", expected, actual)
+	}
+
+	if actual := xmlPlugins(`<project><builders plugin="shell@1.0">`); !reflect.DeepEqual(actual, []string{"shell"}) {
+		t.Errorf("Expected the plugins of incomplete documents to be found, but got %v", actual)
+	}
+}
@@ -2,7 +2,10 @@ package jenkins
 
 import (
 	"context"
-	"os"
+	"fmt"
+	"log"
+	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,53 +13,290 @@ import (
 
 // Provider creates a new Jenkins provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"server_url": {
-				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("JENKINS_URL", nil),
-				Description: "The URL of the Jenkins server to connect to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("JENKINS_URL", nil),
+				Description:      "The URL of the Jenkins server to connect to, including any context path that Jenkins is served under.",
+				ValidateDiagFunc: validateServerURL,
 			},
 			"ca_cert": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("JENKINS_CA_CERT", nil),
-				Description: "The path to the Jenkins self-signed certificate.",
+				Description: "The PEM-encoded CA certificate, or the path to it, that the Jenkins server certificate was issued by.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("JENKINS_INSECURE_SKIP_VERIFY", false),
+				Description: "Disables verification of the Jenkins server certificate. This should only be used for testing.",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("JENKINS_PROXY_URL", nil),
+				Description: "The URL of an HTTP, HTTPS or SOCKS5 proxy to reach Jenkins through. Defaults to the standard HTTP_PROXY/HTTPS_PROXY environment variables.",
+			},
+			"no_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("JENKINS_NO_PROXY", nil),
+				Description:  "A comma-separated list of hosts that should not be reached through the proxy_url.",
+				RequiredWith: []string{"proxy_url"},
+			},
+			"default_folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("JENKINS_DEFAULT_FOLDER", nil),
+				Description:      "A folder that the folder of every resource is relative to, unless it starts with a \"/\".",
+				ValidateDiagFunc: validateFolderName,
+			},
+			"default_credentials_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("JENKINS_DEFAULT_CREDENTIALS_DOMAIN", "_"),
+				Description: "The credentials domain used by all credentials that do not specify a domain. Defaults to the global domain.",
+			},
+			"startup_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("JENKINS_STARTUP_TIMEOUT", nil),
+				Description:      "How long to wait for Jenkins to finish starting up before connecting, such as when it is provisioned in the same apply. By default the provider does not wait.",
+				ValidateDiagFunc: validateDuration,
+			},
+			"health_endpoint": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("JENKINS_HEALTH_ENDPOINT", nil),
+				Description:      "A path relative to the server_url, or a full URL, that responds successfully once Jenkins is ready. By default the Jenkins API itself is checked.",
+				ValidateDiagFunc: validateHealthEndpoint,
+			},
+			"disable_crumb": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skips fetching a CSRF crumb before each change. Crumbs are not required when authenticating with an API token.",
+			},
+			"cache_crumb": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reuses the first CSRF crumb fetched, along with its session, for all later changes rather than fetching a new crumb for each.",
+			},
+			"reuse_session": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reuses the session started by the first request for all later requests, rather than having Jenkins authenticate every request.",
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "The number of times idempotent requests are retried when Jenkins is overloaded or resets the connection. Set to 0 to disable these retries. Waiting for Jenkins to restart is bounded by the resource timeouts instead, and is not affected.",
+			},
+			"retry_min_delay": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "1s",
+				Description:      "The delay before the first retry, which doubles with each subsequent retry.",
+				ValidateDiagFunc: validateDuration,
+			},
+			"retry_max_delay": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "30s",
+				Description:      "The maximum delay between retries.",
+				ValidateDiagFunc: validateDuration,
+			},
+			"max_concurrent_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("JENKINS_MAX_CONCURRENT_REQUESTS", 0),
+				Description: "The maximum number of requests to send to Jenkins at once. Defaults to no limit.",
+			},
+			"requests_per_second": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("JENKINS_REQUESTS_PER_SECOND", 0.0),
+				Description: "The maximum rate at which requests are sent to Jenkins. Defaults to no limit.",
+			},
+			"ssh_tunnel": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Connects to Jenkins through an SSH jump host, for controllers that are only reachable from a bastion.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Description: "The address of the jump host, optionally including the port.",
+							Required:    true,
+						},
+						"user": {
+							Type:        schema.TypeString,
+							Description: "The user to connect to the jump host as.",
+							Required:    true,
+						},
+						"private_key": {
+							Type:        schema.TypeString,
+							Description: "The PEM-encoded private key to authenticate with, or the path to it.",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"host_key": {
+							Type:        schema.TypeString,
+							Description: "The public key of the jump host, in authorized_keys format, that its identity is verified against. Defaults to the keys in ~/.ssh/known_hosts.",
+							Optional:    true,
+						},
+						"insecure_ignore_host_key": {
+							Type:        schema.TypeBool,
+							Description: "Connects to the jump host without verifying its identity.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("JENKINS_CLIENT_CERT", nil),
+				Description:  "The PEM-encoded client certificate, or the path to it, to present to controllers requiring mutual TLS.",
+				RequiredWith: []string{"client_key"},
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("JENKINS_CLIENT_KEY", nil),
+				Description:  "The PEM-encoded private key of the client certificate, or the path to it.",
+				RequiredWith: []string{"client_cert"},
 			},
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("JENKINS_USERNAME", nil),
-				Description: "Username to authenticate to Jenkins.",
+				Description: "Username to authenticate to Jenkins. Required unless bearer_token is set.",
 			},
 			"password": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("JENKINS_PASSWORD", nil),
-				Description: "Password to authenticate to Jenkins.",
+				Description: "Password to authenticate to Jenkins. Either this or api_token must be set.",
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("JENKINS_API_TOKEN", nil),
+				Description: "API token to authenticate to Jenkins with, used instead of the password when set.",
+			},
+			"bearer_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("JENKINS_BEARER_TOKEN", nil),
+				Description: "A token to send as an \"Authorization: Bearer\" header instead of authenticating with a username, for controllers behind an authenticating gateway.",
+			},
+			"headers": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Additional headers to send with every request, such as tenant routing headers or the service tokens expected by an access proxy.",
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateHeaders,
 			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"jenkins_artifact":                 dataSourceJenkinsArtifact(),
+			"jenkins_console_log":              dataSourceJenkinsConsoleLog(),
 			"jenkins_credential_username":      dataSourceJenkinsCredentialUsername(),
 			"jenkins_credential_vault_approle": dataSourceJenkinsCredentialVaultAppRole(),
+			"jenkins_credentials":              dataSourceJenkinsCredentials(),
 			"jenkins_folder":                   dataSourceJenkinsFolder(),
 			"jenkins_job":                      dataSourceJenkinsJob(),
+			"jenkins_jobs":                     dataSourceJenkinsJobs(),
+			"jenkins_label":                    dataSourceJenkinsLabel(),
+			"jenkins_load_statistics":          dataSourceJenkinsLoadStatistics(),
+			"jenkins_nodes":                    dataSourceJenkinsNodes(),
+			"jenkins_plugin":                   dataSourceJenkinsPlugin(),
+			"jenkins_plugins":                  dataSourceJenkinsPlugins(),
+			"jenkins_queue":                    dataSourceJenkinsQueue(),
+			"jenkins_server_info":              dataSourceJenkinsServerInfo(),
+			"jenkins_ssh_host_key":             dataSourceJenkinsSSHHostKey(),
+			"jenkins_update_center":            dataSourceJenkinsUpdateCenter(),
+			"jenkins_user":                     dataSourceJenkinsUser(),
+			"jenkins_whoami":                   dataSourceJenkinsWhoAmI(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"jenkins_credential_secret_file":   resourceJenkinsCredentialSecretFile(),
-			"jenkins_credential_secret_text":   resourceJenkinsCredentialSecretText(),
-			"jenkins_credential_ssh":           resourceJenkinsCredentialSSH(),
-			"jenkins_credential_username":      resourceJenkinsCredentialUsername(),
-			"jenkins_credential_vault_approle": resourceJenkinsCredentialVaultAppRole(),
-			"jenkins_folder":                   resourceJenkinsFolder(),
-			"jenkins_job":                      resourceJenkinsJob(),
+			"jenkins_agent_role":                   resourceJenkinsAgentRole(),
+			"jenkins_agent_to_controller_security": resourceJenkinsAgentToControllerSecurity(),
+			"jenkins_api_token_policy":             resourceJenkinsAPITokenPolicy(),
+			"jenkins_appearance":                   resourceJenkinsAppearance(),
+			"jenkins_artifactory_server":           resourceJenkinsArtifactoryServer(),
+			"jenkins_audit_trail":                  resourceJenkinsAuditTrail(),
+			"jenkins_configuration_as_code":        resourceJenkinsConfigurationAsCode(),
+			"jenkins_credential_secret_file":       resourceJenkinsCredentialSecretFile(),
+			"jenkins_credential_secret_text":       resourceJenkinsCredentialSecretText(),
+			"jenkins_credential_ssh":               resourceJenkinsCredentialSSH(),
+			"jenkins_credential_username":          resourceJenkinsCredentialUsername(),
+			"jenkins_credential_vault_approle":     resourceJenkinsCredentialVaultAppRole(),
+			"jenkins_crumb_issuer":                 resourceJenkinsCrumbIssuer(),
+			"jenkins_email_ext":                    resourceJenkinsEmailExt(),
+			"jenkins_folder":                       resourceJenkinsFolder(),
+			"jenkins_git_global_config":            resourceJenkinsGitGlobalConfig(),
+			"jenkins_github_configuration":         resourceJenkinsGitHubConfiguration(),
+			"jenkins_github_oauth_security_realm":  resourceJenkinsGitHubOAuthSecurityRealm(),
+			"jenkins_gitlab_connection":            resourceJenkinsGitLabConnection(),
+			"jenkins_global_build_discarder":       resourceJenkinsGlobalBuildDiscarder(),
+			"jenkins_global_matrix_authorization":  resourceJenkinsGlobalMatrixAuthorization(),
+			"jenkins_global_role":                  resourceJenkinsGlobalRole(),
+			"jenkins_item_role":                    resourceJenkinsItemRole(),
+			"jenkins_job":                          resourceJenkinsJob(),
+			"jenkins_ldap_security_realm":          resourceJenkinsLDAPSecurityRealm(),
+			"jenkins_local_security_realm":         resourceJenkinsLocalSecurityRealm(),
+			"jenkins_lockable_resource":            resourceJenkinsLockableResource(),
+			"jenkins_log_recorder":                 resourceJenkinsLogRecorder(),
+			"jenkins_mailer":                       resourceJenkinsMailer(),
+			"jenkins_markup_formatter":             resourceJenkinsMarkupFormatter(),
+			"jenkins_oidc_security_realm":          resourceJenkinsOIDCSecurityRealm(),
+			"jenkins_pipeline_durability":          resourceJenkinsPipelineDurability(),
+			"jenkins_plugin":                       resourceJenkinsPlugin(),
+			"jenkins_quiet_down":                   resourceJenkinsQuietDown(),
+			"jenkins_safe_restart":                 resourceJenkinsSafeRestart(),
+			"jenkins_saml_security_realm":          resourceJenkinsSAMLSecurityRealm(),
+			"jenkins_script":                       resourceJenkinsScript(),
+			"jenkins_script_approval":              resourceJenkinsScriptApproval(),
+			"jenkins_slack":                        resourceJenkinsSlack(),
+			"jenkins_sonarqube_server":             resourceJenkinsSonarQubeServer(),
+			"jenkins_sshd":                         resourceJenkinsSSHD(),
+			"jenkins_thin_backup":                  resourceJenkinsThinBackup(),
+			"jenkins_timestamper":                  resourceJenkinsTimestamper(),
+			"jenkins_update_site":                  resourceJenkinsUpdateSite(),
+			"jenkins_usage_statistics":             resourceJenkinsUsageStatistics(),
+			"jenkins_user":                         resourceJenkinsUser(),
+			"jenkins_user_ssh_keys":                resourceJenkinsUserSSHKeys(),
 		},
 
 		ConfigureContextFunc: configureProvider,
 	}
+
+	for name, resource := range provider.ResourcesMap {
+		versionResource(resource)
+		retryResource(resource)
+		traceResource(name, resource)
+	}
+	for name, resource := range provider.DataSourcesMap {
+		traceResource(name, resource)
+	}
+	return provider
 }
 
 func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -64,21 +304,224 @@ func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}
 		ServerURL: d.Get("server_url").(string),
 		Username:  d.Get("username").(string),
 		Password:  d.Get("password").(string),
+		APIToken:  d.Get("api_token").(string),
+
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		ProxyURL:           d.Get("proxy_url").(string),
+		NoProxy:            d.Get("no_proxy").(string),
+		MaxRetries:         d.Get("max_retries").(int),
+		DefaultFolder:      d.Get("default_folder").(string),
+		HealthEndpoint:     d.Get("health_endpoint").(string),
+
+		DefaultCredentialsDomain: d.Get("default_credentials_domain").(string),
+		DisableCrumb:             d.Get("disable_crumb").(bool),
+		CacheCrumb:               d.Get("cache_crumb").(bool),
+
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		RequestsPerSecond:     d.Get("requests_per_second").(float64),
+
+		BearerToken: d.Get("bearer_token").(string),
+		Headers:     map[string]string{},
+	}
+	for key, value := range d.Get("headers").(map[string]interface{}) {
+		config.Headers[key] = value.(string)
+	}
+
+	if config.BearerToken != "" {
+		// The gateway authenticates the requests, so basic authentication would only conflict with the token
+		config.Username = ""
+	} else if config.Username == "" {
+		return nil, diag.Errorf("Either a username or a bearer token must be provided to authenticate to Jenkins")
+	} else if config.Password == "" && config.APIToken == "" {
+		return nil, diag.Errorf("Either a password or an API token must be provided to authenticate to Jenkins")
 	}
 
+	// Redact the values of all sensitive attributes from the logs, as they are sent to Jenkins under the same names
+	provider := Provider()
+	config.SensitiveFields = sensitiveAttributeNames(provider.ResourcesMap, provider.DataSourcesMap)
+
+	// Durations have already been validated by the schema
+	config.RetryMinDelay, _ = time.ParseDuration(d.Get("retry_min_delay").(string))
+	config.RetryMaxDelay, _ = time.ParseDuration(d.Get("retry_max_delay").(string))
+
 	// Read the certificate
 	var err error
 	if d.Get("ca_cert").(string) != "" {
-		config.CACert, err = os.Open(d.Get("ca_cert").(string))
+		if config.CACert, err = readPEM(d.Get("ca_cert").(string)); err != nil {
+			return nil, diag.Errorf("Unable to read certificate: %s", err.Error())
+		}
+	}
+
+	// Read the client certificate
+	if d.Get("client_cert").(string) != "" {
+		if config.ClientCert, err = readPEM(d.Get("client_cert").(string)); err != nil {
+			return nil, diag.Errorf("Unable to read client certificate: %s", err.Error())
+		}
+		if config.ClientKey, err = readPEM(d.Get("client_key").(string)); err != nil {
+			return nil, diag.Errorf("Unable to read client key: %s", err.Error())
+		}
+	}
+
+	// Prepare the tunnel to connect through
+	if tunnels := d.Get("ssh_tunnel").([]interface{}); len(tunnels) > 0 && tunnels[0] != nil {
+		tunnel := tunnels[0].(map[string]interface{})
+		key, err := readPEM(tunnel["private_key"].(string))
+		if err != nil {
+			return nil, diag.Errorf("Unable to read SSH private key: %s", err.Error())
+		}
+		hostKeyCallback, err := sshHostKeyCallback(tunnel["host_key"].(string), defaultKnownHostsFile(), tunnel["insecure_ignore_host_key"].(bool))
 		if err != nil {
-			return nil, diag.Errorf("Unable to open certificate file %s: %s", d.Get("ca_cert").(string), err.Error())
+			return nil, diag.FromErr(err)
+		}
+		if config.SSHTunnel, err = newSSHTunnel(tunnel["host"].(string), tunnel["user"].(string), key, hostKeyCallback); err != nil {
+			return nil, diag.FromErr(err)
 		}
 	}
 
-	client := newJenkinsClient(&config)
+	client, err := newJenkinsClient(&config)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if timeout := d.Get("startup_timeout").(string); timeout != "" {
+		wait, _ := time.ParseDuration(timeout)
+		log.Printf("[DEBUG] jenkins::provider - Waiting up to %s for Jenkins to be ready", wait)
+		if err := waitForJenkins(ctx, client, wait); err != nil {
+			return nil, diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Jenkins did not become ready",
+				Detail:   fmt.Sprintf("%s did not finish starting up within %s: %s", config.ServerURL, wait, err),
+			}}
+		}
+	}
+	if diags := validateConnection(ctx, client, config.ServerURL); diags.HasError() {
+		return nil, diags
+	}
 	if _, err = client.Init(ctx); err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	return client, nil
+	return client, checkCompatibility(ctx, client, client.Version)
+}
+
+// schemaVersion is the version of the state written by all resources. Resources that change the format of their
+// state, such as their IDs, increment their own SchemaVersion from here and add a StateUpgrader to migrate existing states.
+const schemaVersion = 1
+
+// versionResource versions the state of a resource that does not yet define its own upgrades. States written before
+// resources were versioned are at version 0, and need no changes to be read as the current version.
+func versionResource(resource *schema.Resource) {
+	if resource.SchemaVersion != 0 {
+		return
+	}
+
+	resource.SchemaVersion = schemaVersion
+	resource.StateUpgraders = []schema.StateUpgrader{{
+		Version: 0,
+		Type:    resource.CoreConfigSchema().ImpliedType(),
+		Upgrade: upgradeUnversionedState,
+	}}
+}
+
+func upgradeUnversionedState(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return rawState, nil
+}
+
+// sensitiveAttributeNames collects the names of all attributes marked as sensitive within the given resources.
+func sensitiveAttributeNames(resources ...map[string]*schema.Resource) []string {
+	names := map[string]bool{}
+
+	var walk func(map[string]*schema.Schema)
+	walk = func(attributes map[string]*schema.Schema) {
+		for name, attribute := range attributes {
+			if attribute.Sensitive {
+				names[name] = true
+			}
+			if elem, ok := attribute.Elem.(*schema.Resource); ok {
+				walk(elem.Schema)
+			}
+		}
+	}
+	for _, resourceMap := range resources {
+		for _, resource := range resourceMap {
+			walk(resource.Schema)
+		}
+	}
+
+	ret := make([]string, 0, len(names))
+	for name := range names {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// validateConnection ensures that the controller can be reached and accepts the configured credentials,
+// so that a misconfigured provider is reported once rather than by the first resource to be refreshed.
+func validateConnection(ctx context.Context, client jenkinsClient, serverURL string) diag.Diagnostics {
+	if _, err := client.ServerInfo(ctx); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to connect to Jenkins",
+			Detail:   fmt.Sprintf("Could not query %s: %s. Verify that the server URL is correct and that the credentials are valid.", serverURL, err),
+		}}
+	}
+
+	who, err := client.WhoAmI(ctx)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to connect to Jenkins",
+			Detail:   fmt.Sprintf("Could not determine the authenticated user: %s", err),
+		}}
+	} else if who.Anonymous || !who.Authenticated {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to authenticate to Jenkins",
+			Detail:   "The controller treated the provider as an anonymous user. Verify that the username and password or API token are correct.",
+		}}
+	}
+
+	log.Printf("[DEBUG] jenkins::provider - Authenticated as %q", who.Name)
+	return nil
+}
+
+// The oldest versions of Jenkins and the credentials plugin that the provider is tested against.
+const (
+	minimumJenkinsVersion     = "2.361.1"
+	minimumCredentialsVersion = "2.6.1"
+)
+
+// checkCompatibility warns when the controller or its credentials plugin are older than the provider supports,
+// as requests against them may then fail with errors that do not point to the version being the cause. Controllers
+// that old are warned about rather than adapted to, see credentialsDomainURL for the request formats involved.
+func checkCompatibility(ctx context.Context, client jenkinsClient, coreVersion string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	log.Printf("[DEBUG] jenkins::provider - Connected to Jenkins %s", coreVersion)
+	if coreVersion != "" && compareVersions(coreVersion, minimumJenkinsVersion) < 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unsupported Jenkins version",
+			Detail:   fmt.Sprintf("Jenkins %s is older than the oldest supported version, %s. Some resources may fail to apply until Jenkins is upgraded.", coreVersion, minimumJenkinsVersion),
+		})
+	}
+
+	// Listing plugins requires more than read access, so the check is skipped for users that cannot
+	plugins, err := client.GetPlugins(ctx, 1)
+	if err != nil {
+		log.Printf("[DEBUG] jenkins::provider - Unable to determine plugin versions: %v", err)
+		return diags
+	}
+	if plugin := plugins.Contains("credentials"); plugin != nil {
+		log.Printf("[DEBUG] jenkins::provider - Connected to credentials plugin %s", plugin.Version)
+		if compareVersions(plugin.Version, minimumCredentialsVersion) < 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unsupported credentials plugin version",
+				Detail:   fmt.Sprintf("The credentials plugin %s is older than the oldest supported version, %s. Credentials resources may fail to apply until the plugin is upgraded.", plugin.Version, minimumCredentialsVersion),
+			})
+		}
+	}
+
+	return diags
 }
@@ -1,7 +1,10 @@
 package jenkins
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,7 +33,150 @@ func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("JENKINS_USERNAME"); v == "" {
 		t.Fatal("JENKINS_USERNAME must be set for acceptance tests")
 	}
-	if v := os.Getenv("JENKINS_PASSWORD"); v == "" {
-		t.Fatal("JENKINS_PASSWORD must be set for acceptance tests")
+	if os.Getenv("JENKINS_PASSWORD") == "" && os.Getenv("JENKINS_API_TOKEN") == "" {
+		t.Fatal("JENKINS_PASSWORD or JENKINS_API_TOKEN must be set for acceptance tests")
+	}
+}
+
+func TestProvider_schemaVersion(t *testing.T) {
+	for name, resource := range Provider().ResourcesMap {
+		if resource.SchemaVersion < schemaVersion || len(resource.StateUpgraders) == 0 || resource.StateUpgraders[0].Version != 0 {
+			t.Errorf("Expected %s to upgrade unversioned states, but it is at version %d", name, resource.SchemaVersion)
+		}
+	}
+
+	state := map[string]interface{}{"id": "folder-name", "name": "folder-name"}
+	upgraded, err := Provider().ResourcesMap["jenkins_folder"].StateUpgraders[0].Upgrade(context.Background(), state, nil)
+	if err != nil || !reflect.DeepEqual(upgraded, state) {
+		t.Errorf("Expected the state to be kept but received %v: %v", upgraded, err)
+	}
+}
+
+func TestProvider_timeouts(t *testing.T) {
+	for name, resource := range Provider().ResourcesMap {
+		timeouts := resource.Timeouts
+		if timeouts == nil || timeouts.Create == nil || timeouts.Read == nil || timeouts.Update == nil || timeouts.Delete == nil {
+			t.Errorf("Expected %s to bound every operation, but it has timeouts %+v", name, timeouts)
+		}
+	}
+}
+
+func TestValidateConnection(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *mockJenkinsClient
+		wantErr string
+	}{
+		{
+			name: "authenticated",
+			client: &mockJenkinsClient{
+				mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+					return &serverInfo{}, nil
+				},
+				mockWhoAmI: func(ctx context.Context) (*whoAmI, error) {
+					return &whoAmI{Name: "admin", Authenticated: true}, nil
+				},
+			},
+		},
+		{
+			name: "unreachable",
+			client: &mockJenkinsClient{
+				mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+					return nil, fmt.Errorf("401 Unauthorized")
+				},
+			},
+			wantErr: "Unable to connect to Jenkins",
+		},
+		{
+			name: "anonymous",
+			client: &mockJenkinsClient{
+				mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+					return &serverInfo{}, nil
+				},
+				mockWhoAmI: func(ctx context.Context) (*whoAmI, error) {
+					return &whoAmI{Name: "anonymous", Anonymous: true}, nil
+				},
+			},
+			wantErr: "Unable to authenticate to Jenkins",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateConnection(context.Background(), tt.client, "http://localhost:8080")
+			if tt.wantErr == "" && diags.HasError() {
+				t.Errorf("validateConnection() unexpected error %v", diags)
+			} else if tt.wantErr != "" && (!diags.HasError() || diags[0].Summary != tt.wantErr) {
+				t.Errorf("validateConnection() = %v, want %q", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	plugins := func(version string) func(ctx context.Context, depth int) (installedPlugins, error) {
+		return func(ctx context.Context, depth int) (installedPlugins, error) {
+			return installedPlugins{{ShortName: "credentials", Version: version}}, nil
+		}
+	}
+
+	tests := []struct {
+		name     string
+		core     string
+		client   *mockJenkinsClient
+		warnings []string
+	}{
+		{
+			name:   "supported",
+			core:   "2.401.3",
+			client: &mockJenkinsClient{mockGetPlugins: plugins("1319.v7eb_51b_3a_c97b_")},
+		},
+		{
+			name:     "old core",
+			core:     "2.289.3",
+			client:   &mockJenkinsClient{mockGetPlugins: plugins("1319.v7eb_51b_3a_c97b_")},
+			warnings: []string{"Unsupported Jenkins version"},
+		},
+		{
+			name:     "old credentials",
+			core:     "2.401.3",
+			client:   &mockJenkinsClient{mockGetPlugins: plugins("2.3.19")},
+			warnings: []string{"Unsupported credentials plugin version"},
+		},
+		{
+			name: "plugins unavailable",
+			core: "2.401.3",
+			client: &mockJenkinsClient{
+				mockGetPlugins: func(ctx context.Context, depth int) (installedPlugins, error) {
+					return nil, fmt.Errorf("403 Forbidden")
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkCompatibility(context.Background(), tt.client, tt.core)
+			if diags.HasError() || len(diags) != len(tt.warnings) {
+				t.Fatalf("checkCompatibility() = %v, want %v", diags, tt.warnings)
+			}
+			for i, summary := range tt.warnings {
+				if diags[i].Summary != summary {
+					t.Errorf("checkCompatibility() = %v, want %v", diags, tt.warnings)
+				}
+			}
+		})
+	}
+}
+
+func TestSensitiveAttributeNames(t *testing.T) {
+	names := sensitiveAttributeNames(testAccProvider.ResourcesMap)
+
+	for _, expected := range []string{"password", "client_secret"} {
+		found := false
+		for _, name := range names {
+			found = found || name == expected
+		}
+		if !found {
+			t.Errorf("Expected %q to be reported as sensitive in %v", expected, names)
+		}
 	}
 }
@@ -0,0 +1,126 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsAgentRole_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_agent_role foo {
+				  name        = "tf-acc-test-%s"
+				  pattern     = "^tf-acc-test-.*"
+				  permissions = ["hudson.model.Computer.Connect"]
+				  sids        = ["authenticated"]
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_agent_role.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_agent_role.foo", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_agent_role.foo", "sids.#", "1"),
+				),
+			},
+			{
+				// Update by narrowing the pattern
+				Config: fmt.Sprintf(`
+				resource jenkins_agent_role foo {
+				  name        = "tf-acc-test-%s"
+				  pattern     = "^tf-acc-test-%s-.*"
+				  permissions = ["hudson.model.Computer.Connect"]
+				  sids        = ["authenticated", "admin"]
+				}`, randString, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_agent_role.foo", "pattern", "^tf-acc-test-"+randString+"-.*"),
+					resource.TestCheckResourceAttr("jenkins_agent_role.foo", "sids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsAgentRoleRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        diag.Diagnostics
+		wantID      string
+		wantSids    int
+		wantPattern string
+	}{
+		{
+			name: "exists",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"permissions":["hudson.model.Computer.Connect"],"sids":["alice","developers"],"pattern":"^team-a/.*"}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsAgentRole().Schema, map[string]interface{}{}),
+			},
+			wantID:      "team-a",
+			wantSids:    2,
+			wantPattern: "^team-a/.*",
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsAgentRole().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "not role-based",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"error":"java.lang.IllegalStateException: Jenkins is not using the role-based authorization strategy"}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsAgentRole().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::read - Error reading agent role \"team-a\": script failed: java.lang.IllegalStateException: Jenkins is not using the role-based authorization strategy"},
+			},
+			wantID: "team-a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("team-a")
+			if got := resourceJenkinsAgentRole().ReadContext(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsAgentRoleRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsAgentRoleRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+			if got := tt.args.d.Get("sids").(*schema.Set).Len(); got != tt.wantSids {
+				t.Errorf("resourceJenkinsAgentRoleRead() sids = %v, want %v", got, tt.wantSids)
+			}
+			if got := tt.args.d.Get("pattern").(string); got != tt.wantPattern {
+				t.Errorf("resourceJenkinsAgentRoleRead() pattern = %q, want %q", got, tt.wantPattern)
+			}
+		})
+	}
+}
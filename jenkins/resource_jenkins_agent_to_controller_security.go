@@ -0,0 +1,118 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The allowlists are kept in the same files under secrets/ that the Jenkins UI edits, with one entry per line.
+// Newer versions of Jenkins have dropped the allowlists entirely, in which case they are reported as empty.
+const agentToControllerSecurityPrelude = `
+	def rule = jenkins.model.Jenkins.get().injector.getInstance(jenkins.security.s2m.AdminWhitelistRule)
+	def config = { name -> rule.class.declaredFields.any { it.name == name } ? rule.@"$name" : null }
+`
+
+const agentToControllerSecurityReadScript = agentToControllerSecurityPrelude + `
+	def lines = { file -> file?.@file?.exists() ? file.@file.text.readLines()*.trim().findAll { it } : [] }
+	return [
+		enabled:          !rule.masterKillSwitch,
+		allowed_commands: lines(config('whitelisted')),
+		file_path_rules:  lines(config('filePathRules')),
+	]
+`
+
+const agentToControllerSecurityUpdateScript = agentToControllerSecurityPrelude + `
+	def write = { name, entries ->
+		def file = config(name)
+		if (file != null) {
+			file.set(entries.join('\n'))
+		} else if (entries) {
+			throw new UnsupportedOperationException('This version of Jenkins no longer supports agent to controller allowlists')
+		}
+	}
+	rule.masterKillSwitch = !input.enabled
+	write('whitelisted', input.allowed_commands)
+	write('filePathRules', input.file_path_rules)
+`
+
+func resourceJenkinsAgentToControllerSecurity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsAgentToControllerSecurityCreate,
+		ReadContext:   resourceJenkinsAgentToControllerSecurityRead,
+		UpdateContext: resourceJenkinsAgentToControllerSecurityUpdate,
+		DeleteContext: resourceJenkinsAgentToControllerSecurityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether agents are restricted in the commands they may send to the controller.",
+				Optional:    true,
+				Default:     true,
+			},
+			"allowed_commands": {
+				Type:        schema.TypeList,
+				Description: "The class names of the additional agent commands allowed to run on the controller.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"file_path_rules": {
+				Type:        schema.TypeList,
+				Description: "The additional rules for agent access to files on the controller, such as \"allow read,stat <BUILDDIR>/.*\".",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceJenkinsAgentToControllerSecurityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "enabled", "allowed_commands", "file_path_rules")
+	if err := executeScript(ctx, client, agentToControllerSecurityUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring agent to controller security: %w", err))
+	}
+
+	d.SetId("agent-to-controller-security")
+	return resourceJenkinsAgentToControllerSecurityRead(ctx, d, meta)
+}
+
+func resourceJenkinsAgentToControllerSecurityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, agentToControllerSecurityReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading agent to controller security: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsAgentToControllerSecurityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "enabled", "allowed_commands", "file_path_rules")
+	if err := executeScript(ctx, client, agentToControllerSecurityUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring agent to controller security: %w", err))
+	}
+
+	return resourceJenkinsAgentToControllerSecurityRead(ctx, d, meta)
+}
+
+func resourceJenkinsAgentToControllerSecurityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Restore the Jenkins default of enforcing the built-in rules only
+	input := map[string]interface{}{"enabled": true, "allowed_commands": []string{}, "file_path_rules": []string{}}
+	if err := executeScript(ctx, client, agentToControllerSecurityUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring agent to controller security: %w", err))
+	}
+
+	return nil
+}
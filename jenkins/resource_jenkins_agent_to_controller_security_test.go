@@ -0,0 +1,70 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsAgentToControllerSecurity_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_agent_to_controller_security foo {
+				  file_path_rules = ["allow read,stat <BUILDDIR>/.*"]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_agent_to_controller_security.foo", "id", "agent-to-controller-security"),
+					resource.TestCheckResourceAttr("jenkins_agent_to_controller_security.foo", "enabled", "true"),
+					resource.TestCheckResourceAttr("jenkins_agent_to_controller_security.foo", "file_path_rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsAgentToControllerSecurityRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":{"enabled":true,"allowed_commands":[],"file_path_rules":["allow read,stat <BUILDDIR>/.*","deny all <JENKINS_HOME>/secrets/.*"]}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsAgentToControllerSecurity().Schema, map[string]interface{}{})
+	d.SetId("agent-to-controller-security")
+
+	if got := resourceJenkinsAgentToControllerSecurityRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsAgentToControllerSecurityRead() = %v, want nil", got)
+	}
+	if got := d.Get("file_path_rules").([]interface{}); len(got) != 2 || got[1] != "deny all <JENKINS_HOME>/secrets/.*" {
+		t.Errorf("Expected the file path rules to be read in order but received %v", got)
+	}
+}
+
+func Test_resourceJenkinsAgentToControllerSecurityDelete(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if !strings.Contains(script, `"enabled":true`) || !strings.Contains(script, `"file_path_rules":[]`) {
+				t.Errorf("Expected the defaults to be restored: %s", script)
+			}
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsAgentToControllerSecurity().Schema, map[string]interface{}{
+		"enabled": false,
+	})
+	d.SetId("agent-to-controller-security")
+
+	if got := resourceJenkinsAgentToControllerSecurityDelete(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsAgentToControllerSecurityDelete() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,105 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const apiTokenPolicyReadScript = `
+	def config = jenkins.security.apitoken.ApiTokenPropertyConfiguration.get()
+	return [
+		legacy_token_on_user_creation: config.tokenGenerationOnCreationEnabled,
+		legacy_token_creation:         config.creationOfLegacyTokenEnabled,
+		usage_statistics:              config.usageStatisticsEnabled,
+	]
+`
+
+const apiTokenPolicyUpdateScript = `
+	def config = jenkins.security.apitoken.ApiTokenPropertyConfiguration.get()
+	config.tokenGenerationOnCreationEnabled = input.legacy_token_on_user_creation
+	config.creationOfLegacyTokenEnabled = input.legacy_token_creation
+	config.usageStatisticsEnabled = input.usage_statistics
+	config.save()
+`
+
+func resourceJenkinsAPITokenPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsAPITokenPolicyCreate,
+		ReadContext:   resourceJenkinsAPITokenPolicyRead,
+		UpdateContext: resourceJenkinsAPITokenPolicyUpdate,
+		DeleteContext: resourceJenkinsAPITokenPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"legacy_token_on_user_creation": {
+				Type:        schema.TypeBool,
+				Description: "Whether a legacy API token is generated for every newly created user.",
+				Optional:    true,
+				Default:     false,
+			},
+			"legacy_token_creation": {
+				Type:        schema.TypeBool,
+				Description: "Whether users may create legacy API tokens.",
+				Optional:    true,
+				Default:     false,
+			},
+			"usage_statistics": {
+				Type:        schema.TypeBool,
+				Description: "Whether the use of each API token is tracked.",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsAPITokenPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "legacy_token_on_user_creation", "legacy_token_creation", "usage_statistics")
+	if err := executeScript(ctx, client, apiTokenPolicyUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring API token policy: %w", err))
+	}
+
+	d.SetId("api-token-policy")
+	return resourceJenkinsAPITokenPolicyRead(ctx, d, meta)
+}
+
+func resourceJenkinsAPITokenPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, apiTokenPolicyReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading API token policy: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsAPITokenPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "legacy_token_on_user_creation", "legacy_token_creation", "usage_statistics")
+	if err := executeScript(ctx, client, apiTokenPolicyUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring API token policy: %w", err))
+	}
+
+	return resourceJenkinsAPITokenPolicyRead(ctx, d, meta)
+}
+
+func resourceJenkinsAPITokenPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Restore the Jenkins defaults, which already disable legacy tokens
+	input := map[string]interface{}{"legacy_token_on_user_creation": false, "legacy_token_creation": false, "usage_statistics": true}
+	if err := executeScript(ctx, client, apiTokenPolicyUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring API token policy: %w", err))
+	}
+
+	return nil
+}
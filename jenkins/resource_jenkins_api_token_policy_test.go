@@ -0,0 +1,57 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsAPITokenPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_api_token_policy foo {
+				  legacy_token_creation = false
+				  usage_statistics      = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_api_token_policy.foo", "id", "api-token-policy"),
+					resource.TestCheckResourceAttr("jenkins_api_token_policy.foo", "legacy_token_creation", "false"),
+					resource.TestCheckResourceAttr("jenkins_api_token_policy.foo", "usage_statistics", "true"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsAPITokenPolicyCreate(t *testing.T) {
+	var scripts []string
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			scripts = append(scripts, script)
+			return `{"result":{"legacy_token_on_user_creation":false,"legacy_token_creation":false,"usage_statistics":false}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsAPITokenPolicy().Schema, map[string]interface{}{
+		"usage_statistics": false,
+	})
+
+	if got := resourceJenkinsAPITokenPolicyCreate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsAPITokenPolicyCreate() = %v, want nil", got)
+	}
+	if d.Id() != "api-token-policy" {
+		t.Errorf("Expected ID %q but received %q", "api-token-policy", d.Id())
+	}
+	if len(scripts) != 2 || !strings.Contains(scripts[0], `"usage_statistics":false`) {
+		t.Errorf("Expected the update script to receive the desired settings: %v", scripts)
+	}
+}
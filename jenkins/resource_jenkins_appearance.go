@@ -0,0 +1,113 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const appearanceReadScript = `
+	def decorator = io.jenkins.plugins.thememanager.ThemeManagerPageDecorator.get()
+	return [
+		theme:               decorator.theme?.descriptor?.themeKey ?: '',
+		disable_user_themes: decorator.disableUserThemes,
+		system_message:      jenkins.model.Jenkins.get().systemMessage ?: '',
+	]
+`
+
+const appearanceUpdateScript = `
+	def decorator = io.jenkins.plugins.thememanager.ThemeManagerPageDecorator.get()
+	if (input.theme) {
+		def theme = io.jenkins.plugins.thememanager.ThemeManagerFactoryDescriptor.all().find { it.themeKey == input.theme }
+		if (theme == null) {
+			throw new IllegalArgumentException("Unknown theme: " + input.theme)
+		}
+		decorator.theme = theme.instance
+	} else {
+		decorator.theme = null
+	}
+	decorator.disableUserThemes = input.disable_user_themes ?: false
+	decorator.save()
+
+	def instance = jenkins.model.Jenkins.get()
+	instance.systemMessage = input.system_message ?: null
+	instance.save()
+`
+
+func resourceJenkinsAppearance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsAppearanceCreate,
+		ReadContext:   resourceJenkinsAppearanceRead,
+		UpdateContext: resourceJenkinsAppearanceUpdate,
+		DeleteContext: resourceJenkinsAppearanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"theme": {
+				Type:        schema.TypeString,
+				Description: "The key of the default theme, such as \"dark\" or \"dark-system\". Leave unset for the Jenkins default.",
+				Optional:    true,
+			},
+			"disable_user_themes": {
+				Type:        schema.TypeBool,
+				Description: "Whether users are prevented from choosing their own theme.",
+				Optional:    true,
+				Default:     false,
+			},
+			"system_message": {
+				Type:        schema.TypeString,
+				Description: "A message shown at the top of the dashboard, rendered by the configured markup formatter.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsAppearanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "theme", "disable_user_themes", "system_message")
+	if err := executeScript(ctx, client, appearanceUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring appearance: %w", err))
+	}
+
+	d.SetId("appearance")
+	return resourceJenkinsAppearanceRead(ctx, d, meta)
+}
+
+func resourceJenkinsAppearanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, appearanceReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading appearance: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsAppearanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "theme", "disable_user_themes", "system_message")
+	if err := executeScript(ctx, client, appearanceUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring appearance: %w", err))
+	}
+
+	return resourceJenkinsAppearanceRead(ctx, d, meta)
+}
+
+func resourceJenkinsAppearanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// An empty input restores the default theme and clears the system message
+	if err := executeScript(ctx, client, appearanceUpdateScript, map[string]interface{}{}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring appearance: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsAppearance_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_appearance foo {
+				  theme          = "dark"
+				  system_message = "Staging controller"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_appearance.foo", "id", "appearance"),
+					resource.TestCheckResourceAttr("jenkins_appearance.foo", "theme", "dark"),
+					resource.TestCheckResourceAttr("jenkins_appearance.foo", "system_message", "Staging controller"),
+				),
+			},
+			{
+				// Update by preventing users from overriding the theme
+				Config: `
+				resource jenkins_appearance foo {
+				  theme               = "dark"
+				  disable_user_themes = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_appearance.foo", "disable_user_themes", "true"),
+					resource.TestCheckResourceAttr("jenkins_appearance.foo", "system_message", ""),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsAppearanceCreate(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"error":"java.lang.IllegalArgumentException: Unknown theme: neon"}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsAppearance().Schema, map[string]interface{}{
+		"theme": "neon",
+	})
+
+	want := diag.Diagnostics{
+		diag.Diagnostic{Summary: "jenkins::create - Error configuring appearance: script failed: java.lang.IllegalArgumentException: Unknown theme: neon"},
+	}
+	if got := resourceJenkinsAppearanceCreate(context.Background(), d, meta); !reflect.DeepEqual(got, want) {
+		t.Errorf("resourceJenkinsAppearanceCreate() = %v, want %v", got, want)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected no ID to be set but received %q", d.Id())
+	}
+}
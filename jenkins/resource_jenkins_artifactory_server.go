@@ -0,0 +1,172 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const artifactoryServerReadScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(org.jfrog.hudson.ArtifactoryBuilder.DescriptorImpl)
+	def server = config.artifactoryServers?.find { it.serverId == input.server_id }
+	if (server == null) {
+		return null
+	}
+	return [
+		url:                     server.artifactoryUrl,
+		credentials_id:          server.deployerCredentialsConfig?.credentialsId ?: '',
+		resolver_credentials_id: server.resolverCredentialsConfig?.credentialsId ?: '',
+		timeout:                 server.timeout,
+		bypass_proxy:            server.bypassProxy,
+		connection_retry:        server.connectionRetry,
+		deployment_threads:      server.deploymentThreads,
+	]
+`
+
+const artifactoryServerUpdateScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(org.jfrog.hudson.ArtifactoryBuilder.DescriptorImpl)
+	def server = new org.jfrog.hudson.ArtifactoryServer(
+		input.server_id,
+		input.url,
+		new org.jfrog.hudson.CredentialsConfig('', '', input.credentials_id),
+		input.resolver_credentials_id ? new org.jfrog.hudson.CredentialsConfig('', '', input.resolver_credentials_id) : null,
+		input.timeout as int,
+		input.bypass_proxy,
+		input.connection_retry as Integer,
+		input.deployment_threads as Integer
+	)
+	def servers = (config.artifactoryServers ?: []) as List
+	def index = servers.findIndexOf { it.serverId == input.server_id }
+	if (index < 0) {
+		servers.add(server)
+	} else {
+		servers[index] = server
+	}
+	config.artifactoryServers = servers
+	config.save()
+`
+
+const artifactoryServerDeleteScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(org.jfrog.hudson.ArtifactoryBuilder.DescriptorImpl)
+	config.artifactoryServers = (config.artifactoryServers ?: []).findAll { it.serverId != input.server_id }
+	config.save()
+`
+
+var artifactoryServerProperties = []string{"server_id", "url", "credentials_id", "resolver_credentials_id", "timeout", "bypass_proxy", "connection_retry", "deployment_threads"}
+
+func resourceJenkinsArtifactoryServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsArtifactoryServerCreate,
+		ReadContext:   resourceJenkinsArtifactoryServerRead,
+		UpdateContext: resourceJenkinsArtifactoryServerUpdate,
+		DeleteContext: resourceJenkinsArtifactoryServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the server, as referenced by the Artifactory pipeline steps.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the Artifactory server.",
+				Required:    true,
+			},
+			"credentials_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the username/password credential used to deploy artifacts.",
+				Required:    true,
+			},
+			"resolver_credentials_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the username/password credential used to resolve artifacts, if different from the deployer's.",
+				Optional:    true,
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Description: "The connection timeout in seconds.",
+				Optional:    true,
+				Default:     300,
+			},
+			"bypass_proxy": {
+				Type:        schema.TypeBool,
+				Description: "Whether to bypass the Jenkins proxy configuration.",
+				Optional:    true,
+				Default:     false,
+			},
+			"connection_retry": {
+				Type:        schema.TypeInt,
+				Description: "The number of times a failed request is retried.",
+				Optional:    true,
+				Default:     3,
+			},
+			"deployment_threads": {
+				Type:        schema.TypeInt,
+				Description: "The number of threads used to deploy artifacts.",
+				Optional:    true,
+				Default:     3,
+			},
+		},
+	}
+}
+
+func resourceJenkinsArtifactoryServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	id := d.Get("server_id").(string)
+
+	if err := executeScript(ctx, client, artifactoryServerUpdateScript, scriptInput(d, artifactoryServerProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring Artifactory server %q: %w", id, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - Artifactory server %q configured", id)
+	d.SetId(id)
+
+	return resourceJenkinsArtifactoryServerRead(ctx, d, meta)
+}
+
+func resourceJenkinsArtifactoryServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"server_id": d.Id()}
+	if err := executeScript(ctx, client, artifactoryServerReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading Artifactory server %q: %w", d.Id(), err))
+	}
+
+	if len(output) == 0 {
+		// Artifactory server does not exist
+		d.SetId("")
+		return nil
+	}
+
+	output["server_id"] = d.Id()
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsArtifactoryServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, artifactoryServerUpdateScript, scriptInput(d, artifactoryServerProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring Artifactory server %q: %w", d.Id(), err))
+	}
+
+	return resourceJenkinsArtifactoryServerRead(ctx, d, meta)
+}
+
+func resourceJenkinsArtifactoryServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := map[string]interface{}{"server_id": d.Id()}
+	if err := executeScript(ctx, client, artifactoryServerDeleteScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error removing Artifactory server %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsArtifactoryServer_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_username deployer {
+				  name     = "tf-acc-test-%[1]s"
+				  username = "deployer"
+				  password = "hunter2"
+				}
+
+				resource jenkins_artifactory_server foo {
+				  server_id      = "tf-acc-test-%[1]s"
+				  url            = "https://artifactory.example.com/artifactory"
+				  credentials_id = jenkins_credential_username.deployer.name
+				  timeout        = 60
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_artifactory_server.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_artifactory_server.foo", "url", "https://artifactory.example.com/artifactory"),
+					resource.TestCheckResourceAttr("jenkins_artifactory_server.foo", "credentials_id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_artifactory_server.foo", "timeout", "60"),
+					resource.TestCheckResourceAttr("jenkins_artifactory_server.foo", "connection_retry", "3"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsArtifactoryServerRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        diag.Diagnostics
+		wantID      string
+		wantTimeout int
+	}{
+		{
+			name: "exists",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"url":"https://artifactory.example.com","credentials_id":"deployer","resolver_credentials_id":"","timeout":60,"bypass_proxy":false,"connection_retry":3,"deployment_threads":3}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsArtifactoryServer().Schema, map[string]interface{}{}),
+			},
+			wantID:      "artifactory",
+			wantTimeout: 60,
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsArtifactoryServer().Schema, map[string]interface{}{}),
+			},
+			wantTimeout: 300,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("artifactory")
+			if got := resourceJenkinsArtifactoryServerRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsArtifactoryServerRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsArtifactoryServerRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+			if got := tt.args.d.Get("timeout").(int); got != tt.wantTimeout {
+				t.Errorf("resourceJenkinsArtifactoryServerRead() timeout = %v, want %v", got, tt.wantTimeout)
+			}
+		})
+	}
+}
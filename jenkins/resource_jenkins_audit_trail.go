@@ -0,0 +1,230 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const auditTrailReadScript = `
+	def plugin = jenkins.model.GlobalConfiguration.all().get(hudson.plugins.audit_trail.AuditTrailPlugin)
+	def loggers = plugin.loggers ?: []
+	return [
+		pattern:               plugin.pattern ?: '',
+		log_build_cause:       plugin.logBuildCause,
+		log_credentials_usage: plugin.logCredentialsUsage,
+		display_user_name:     plugin.displayUserName,
+		file_logger: loggers.findAll { it instanceof hudson.plugins.audit_trail.LogFileAuditLogger }.collect { [
+			path:      it.log,
+			limit:     it.limit,
+			count:     it.count,
+			separator: it.logSeparator ?: '',
+		] },
+		syslog_logger: loggers.findAll { it instanceof hudson.plugins.audit_trail.SyslogAuditLogger }.collect { [
+			server_hostname:  it.syslogServerHostname,
+			server_port:      it.syslogServerPort,
+			app_name:         it.appName ?: '',
+			facility:         it.facility,
+			message_format:   it.messageFormat,
+			message_hostname: it.messageHostname ?: '',
+		] },
+	]
+`
+
+// Loggers are built through their data-bound constructors, as their constructor arguments have changed
+// between releases of the plugin. Any console loggers configured through the UI are left in place.
+const auditTrailUpdateScript = `
+	def model = { type, args -> org.jenkinsci.plugins.structs.describable.DescribableModel.of(type).instantiate(args) }
+	def plugin = jenkins.model.GlobalConfiguration.all().get(hudson.plugins.audit_trail.AuditTrailPlugin)
+	if (input.pattern) {
+		plugin.pattern = input.pattern
+	}
+	plugin.logBuildCause = input.log_build_cause
+	plugin.logCredentialsUsage = input.log_credentials_usage
+	plugin.displayUserName = input.display_user_name
+
+	def loggers = (plugin.loggers ?: []).findAll {
+		!(it instanceof hudson.plugins.audit_trail.LogFileAuditLogger) && !(it instanceof hudson.plugins.audit_trail.SyslogAuditLogger)
+	}
+	loggers += input.file_logger.collect {
+		model(hudson.plugins.audit_trail.LogFileAuditLogger, [log: it.path, limit: it.limit, count: it.count, logSeparator: it.separator])
+	}
+	loggers += input.syslog_logger.collect {
+		model(hudson.plugins.audit_trail.SyslogAuditLogger, [
+			syslogServerHostname: it.server_hostname,
+			syslogServerPort:     it.server_port,
+			appName:              it.app_name,
+			facility:             it.facility,
+			messageFormat:        it.message_format,
+			messageHostname:      it.message_hostname,
+		])
+	}
+	plugin.loggers = loggers
+	plugin.save()
+`
+
+var auditTrailProperties = []string{"pattern", "log_build_cause", "log_credentials_usage", "display_user_name", "file_logger", "syslog_logger"}
+
+func resourceJenkinsAuditTrail() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsAuditTrailCreate,
+		ReadContext:   resourceJenkinsAuditTrailRead,
+		UpdateContext: resourceJenkinsAuditTrailUpdate,
+		DeleteContext: resourceJenkinsAuditTrailDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:        schema.TypeString,
+				Description: "A regular expression matching the request URLs to audit. Defaults to the plugin's own pattern.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"log_build_cause": {
+				Type:        schema.TypeBool,
+				Description: "Whether to audit the cause of each build.",
+				Optional:    true,
+				Default:     true,
+			},
+			"log_credentials_usage": {
+				Type:        schema.TypeBool,
+				Description: "Whether to audit the use of credentials.",
+				Optional:    true,
+				Default:     true,
+			},
+			"display_user_name": {
+				Type:        schema.TypeBool,
+				Description: "Whether to log the display names of users along with their IDs.",
+				Optional:    true,
+				Default:     false,
+			},
+			"file_logger": {
+				Type:        schema.TypeList,
+				Description: "Writes the audit log to rotated files on the controller.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "The path of the log file, which may contain %g for the rotation number.",
+							Required:    true,
+						},
+						"limit": {
+							Type:        schema.TypeInt,
+							Description: "The size in megabytes a log file may grow to before being rotated.",
+							Optional:    true,
+							Default:     1,
+						},
+						"count": {
+							Type:        schema.TypeInt,
+							Description: "The number of rotated log files to keep.",
+							Optional:    true,
+							Default:     1,
+						},
+						"separator": {
+							Type:        schema.TypeString,
+							Description: "The separator between the fields of each log entry.",
+							Optional:    true,
+							Default:     " ",
+						},
+					},
+				},
+			},
+			"syslog_logger": {
+				Type:        schema.TypeList,
+				Description: "Sends the audit log to a syslog server.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_hostname": {
+							Type:        schema.TypeString,
+							Description: "The hostname of the syslog server.",
+							Required:    true,
+						},
+						"server_port": {
+							Type:        schema.TypeInt,
+							Description: "The UDP port of the syslog server.",
+							Optional:    true,
+							Default:     514,
+						},
+						"app_name": {
+							Type:        schema.TypeString,
+							Description: "The application name attached to each message.",
+							Optional:    true,
+							Default:     "jenkins",
+						},
+						"facility": {
+							Type:        schema.TypeString,
+							Description: "The syslog facility of each message, such as \"USER\" or \"LOCAL0\".",
+							Optional:    true,
+							Default:     "USER",
+						},
+						"message_format": {
+							Type:        schema.TypeString,
+							Description: "The format of each message, either RFC_3164 or RFC_5424.",
+							Optional:    true,
+							Default:     "RFC_3164",
+						},
+						"message_hostname": {
+							Type:        schema.TypeString,
+							Description: "The hostname attached to each message. Defaults to the hostname of the controller.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceJenkinsAuditTrailCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, auditTrailProperties...)
+	if err := executeScript(ctx, client, auditTrailUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring audit trail: %w", err))
+	}
+
+	d.SetId("audit-trail")
+	return resourceJenkinsAuditTrailRead(ctx, d, meta)
+}
+
+func resourceJenkinsAuditTrailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, auditTrailReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading audit trail: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsAuditTrailUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, auditTrailProperties...)
+	if err := executeScript(ctx, client, auditTrailUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring audit trail: %w", err))
+	}
+
+	return resourceJenkinsAuditTrailRead(ctx, d, meta)
+}
+
+func resourceJenkinsAuditTrailDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Only the loggers are removed, leaving the remaining settings as they were
+	input := scriptInput(d, auditTrailProperties...)
+	input["file_logger"] = []interface{}{}
+	input["syslog_logger"] = []interface{}{}
+	if err := executeScript(ctx, client, auditTrailUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error removing audit trail loggers: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsAuditTrail_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_audit_trail foo {
+				  file_logger {
+				    path  = "/var/jenkins_home/audit-%g.log"
+				    limit = 10
+				    count = 5
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_audit_trail.foo", "id", "audit-trail"),
+					resource.TestCheckResourceAttrSet("jenkins_audit_trail.foo", "pattern"),
+					resource.TestCheckResourceAttr("jenkins_audit_trail.foo", "file_logger.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_audit_trail.foo", "file_logger.0.count", "5"),
+				),
+			},
+			{
+				// Update by moving to syslog
+				Config: `
+				resource jenkins_audit_trail foo {
+				  syslog_logger {
+				    server_hostname = "syslog.example.com"
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_audit_trail.foo", "file_logger.#", "0"),
+					resource.TestCheckResourceAttr("jenkins_audit_trail.foo", "syslog_logger.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_audit_trail.foo", "syslog_logger.0.server_port", "514"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsAuditTrailRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":{"pattern":".*/configSubmit/?.*","log_build_cause":true,"log_credentials_usage":false,"display_user_name":false,"file_logger":[{"path":"audit-%g.log","limit":10,"count":5,"separator":" "}],"syslog_logger":[]}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsAuditTrail().Schema, map[string]interface{}{})
+	d.SetId("audit-trail")
+
+	if got := resourceJenkinsAuditTrailRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsAuditTrailRead() = %v, want nil", got)
+	}
+	if d.Get("file_logger.0.limit").(int) != 10 {
+		t.Errorf("Expected a file logger limit of 10 but received %v", d.Get("file_logger.0.limit"))
+	}
+	if d.Get("log_credentials_usage").(bool) {
+		t.Errorf("Expected credentials usage not to be logged")
+	}
+}
+
+func Test_resourceJenkinsAuditTrailDelete(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if !strings.Contains(script, `"file_logger":[]`) || !strings.Contains(script, `"syslog_logger":[]`) {
+				t.Errorf("Expected the loggers to be removed: %s", script)
+			}
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsAuditTrail().Schema, map[string]interface{}{
+		"file_logger": []interface{}{map[string]interface{}{"path": "audit-%g.log"}},
+	})
+	d.SetId("audit-trail")
+
+	if got := resourceJenkinsAuditTrailDelete(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsAuditTrailDelete() = %v, want nil", got)
+	}
+}
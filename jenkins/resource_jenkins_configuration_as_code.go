@@ -0,0 +1,80 @@
+package jenkins
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceJenkinsConfigurationAsCode() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsConfigurationAsCodeCreate,
+		ReadContext:   resourceJenkinsConfigurationAsCodeRead,
+		UpdateContext: resourceJenkinsConfigurationAsCodeUpdate,
+		DeleteContext: resourceJenkinsConfigurationAsCodeDelete,
+		Timeouts:      defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "An identifier for this configuration document, used to distinguish multiple documents.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"yaml": {
+				Type:        schema.TypeString,
+				Description: "The Configuration as Code YAML document to apply. Only a checksum of the document is kept in state.",
+				Required:    true,
+				StateFunc:   hashConfigurationAsCode,
+			},
+		},
+	}
+}
+
+func resourceJenkinsConfigurationAsCodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Get("name").(string)
+
+	if err := client.ApplyConfigurationAsCode(ctx, d.Get("yaml").(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error applying configuration as code %q: %w", name, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - configuration as code %q applied", name)
+	d.SetId(name)
+
+	return resourceJenkinsConfigurationAsCodeRead(ctx, d, meta)
+}
+
+func resourceJenkinsConfigurationAsCodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Jenkins does not retain the documents that were applied, so there is nothing to refresh here.
+	// Drift in the document itself is detected through the checksum stored for the "yaml" property.
+	return nil
+}
+
+func resourceJenkinsConfigurationAsCodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Id()
+
+	if err := client.ApplyConfigurationAsCode(ctx, d.Get("yaml").(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error applying configuration as code %q: %w", name, err))
+	}
+
+	return resourceJenkinsConfigurationAsCodeRead(ctx, d, meta)
+}
+
+func resourceJenkinsConfigurationAsCodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Applied configuration cannot be reverted, so removing the resource only drops it from state.
+	log.Printf("[DEBUG] jenkins::delete - Removing configuration as code %q from state", d.Id())
+	return nil
+}
+
+// hashConfigurationAsCode reduces a configuration document to its checksum, so that the
+// potentially sensitive document does not need to be stored in state.
+func hashConfigurationAsCode(val interface{}) string {
+	sum := sha256.Sum256([]byte(val.(string)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,114 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsConfigurationAsCode_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_configuration_as_code foo {
+				  name = "system-message"
+				  yaml = yamlencode({
+				    jenkins = {
+				      systemMessage = "Managed by Terraform"
+				    }
+				  })
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_configuration_as_code.foo", "id", "system-message"),
+					resource.TestCheckResourceAttrSet("jenkins_configuration_as_code.foo", "yaml"),
+				),
+			},
+			{
+				// Update by changing the document
+				Config: `
+				resource jenkins_configuration_as_code foo {
+				  name = "system-message"
+				  yaml = yamlencode({
+				    jenkins = {
+				      systemMessage = "Still managed by Terraform"
+				    }
+				  })
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_configuration_as_code.foo", "id", "system-message"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsConfigurationAsCodeCreate(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name string
+		args args
+		want diag.Diagnostics
+	}{
+		{
+			name: "success",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockApplyConfigurationAsCode: func(ctx context.Context, yaml string) error {
+						return nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsConfigurationAsCode().Schema, map[string]interface{}{
+					"name": "example",
+					"yaml": "jenkins: {}",
+				}),
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockApplyConfigurationAsCode: func(ctx context.Context, yaml string) error {
+						return fmt.Errorf("500 Internal Server Error")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsConfigurationAsCode().Schema, map[string]interface{}{
+					"name": "example",
+					"yaml": "jenkins: {}",
+				}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::create - Error applying configuration as code \"example\": 500 Internal Server Error"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceJenkinsConfigurationAsCodeCreate(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsConfigurationAsCodeCreate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashConfigurationAsCode(t *testing.T) {
+	actual := hashConfigurationAsCode("jenkins: {}")
+	if len(actual) != 64 {
+		t.Errorf("Expected a SHA-256 hex digest but received %s", actual)
+	}
+
+	if actual == hashConfigurationAsCode("jenkins: {systemMessage: changed}") {
+		t.Errorf("Expected differing documents to produce differing checksums")
+	}
+}
@@ -133,9 +133,8 @@ func testAccCheckJenkinsCredentialSecretTextExists(resourceName string, cred *je
 			return fmt.Errorf("ID is not set")
 		}
 
-		manager := client.Credentials()
-		manager.Folder = formatFolderName(rs.Primary.Attributes["folder"])
-		err := manager.GetSingle(ctx, rs.Primary.Attributes["domain"], rs.Primary.Attributes["name"], cred)
+		manager := &credentialsManager{Folder: formatFolderName(rs.Primary.Attributes["folder"])}
+		err := getCredentials(ctx, client, manager, rs.Primary.Attributes["domain"], rs.Primary.Attributes["name"], cred)
 		if err != nil {
 			return fmt.Errorf("Unable to retrieve credentials for %s - %s: %w", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"], err)
 		}
@@ -156,9 +155,8 @@ func testAccCheckJenkinsCredentialSecretTextDestroy(s *terraform.State) error {
 		}
 
 		cred := jenkins.StringCredentials{}
-		manager := client.Credentials()
-		manager.Folder = formatFolderName(rs.Primary.Meta["folder"].(string))
-		err := manager.GetSingle(ctx, rs.Primary.Meta["domain"].(string), rs.Primary.Meta["name"].(string), &cred)
+		manager := &credentialsManager{Folder: formatFolderName(rs.Primary.Meta["folder"].(string))}
+		err := getCredentials(ctx, client, manager, rs.Primary.Meta["domain"].(string), rs.Primary.Meta["name"].(string), &cred)
 		if err == nil {
 			return fmt.Errorf("Credentials still exists: %s - %s", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
 		}
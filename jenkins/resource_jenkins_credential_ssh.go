@@ -3,7 +3,6 @@ package jenkins
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	jenkins "github.com/bndr/gojenkins"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,8 +16,10 @@ func resourceJenkinsCredentialSSH() *schema.Resource {
 		UpdateContext: resourceJenkinsCredentialSSHUpdate,
 		DeleteContext: resourceJenkinsCredentialSSHDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceJenkinsCredentialSSHImport,
+			StateContext: resourceJenkinsCredentialImport,
 		},
+		CustomizeDiff: resourceJenkinsCredentialCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -30,15 +31,16 @@ func resourceJenkinsCredentialSSH() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The domain namespace that the credentials will be added to.",
 				Optional:    true,
-				Default:     "_",
+				Computed:    true,
 				// In-place updates should be possible, but gojenkins does not support move operations
 				ForceNew: true,
 			},
 			"folder": {
-				Type:        schema.TypeString,
-				Description: "The folder namespace that the credentials will be added to.",
-				Optional:    true,
-				ForceNew:    true,
+				Type:             schema.TypeString,
+				Description:      "The folder namespace that the credentials will be added to.",
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateFolderName,
 			},
 			"scope": {
 				Type:             schema.TypeString,
@@ -53,6 +55,12 @@ func resourceJenkinsCredentialSSH() *schema.Resource {
 				Optional:    true,
 				Default:     "Managed by Terraform",
 			},
+			"prevent_destroy_if_in_use": {
+				Type:        schema.TypeBool,
+				Description: "Whether to refuse to delete the credentials while Jenkins has recorded them as used by any job or node.",
+				Optional:    true,
+				Default:     false,
+			},
 			"username": {
 				Type:        schema.TypeString,
 				Description: "Username",
@@ -76,13 +84,15 @@ func resourceJenkinsCredentialSSH() *schema.Resource {
 
 func resourceJenkinsCredentialSSHCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(jenkinsClient)
-	cm := client.Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 
 	// Validate that the folder exists
 	if err := folderExists(ctx, client, cm.Folder); err != nil {
 		return diag.FromErr(fmt.Errorf("invalid folder name '%s' specified: %w", cm.Folder, err))
 	}
+	if err := requirePlugins(ctx, client, credentialsPlugin, sshCredentialPlugin); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Could not create credentials %q: %w", d.Get("name").(string), err))
+	}
 
 	cred := jenkins.SSHCredentials{
 		ID:          d.Get("name").(string),
@@ -100,52 +110,54 @@ func resourceJenkinsCredentialSSHCreate(ctx context.Context, d *schema.ResourceD
 		cred.Passphrase = passphrase
 	}
 
-	domain := d.Get("domain").(string)
-	err := cm.Add(ctx, domain, cred)
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
+	d.Set("domain", domain)
+	err := createCredentials(ctx, client, cm, domain, cred)
 	if err != nil {
 		return diag.Errorf("Could not create ssh credentials: %s", err)
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
-	return resourceJenkinsCredentialSSHRead(ctx, d, meta)
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
+	return readCreated(ctx, d, meta, resourceJenkinsCredentialSSHRead)
 }
 
 func resourceJenkinsCredentialSSHRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 
 	cred := jenkins.SSHCredentials{}
-	err := cm.GetSingle(
+	err := getCredentials(
 		ctx,
-		d.Get("domain").(string),
+		meta.(jenkinsClient),
+		cm,
+		resolveCredentialsDomain(meta, d.Get("domain").(string)),
 		d.Get("name").(string),
 		&cred,
 	)
 
 	if err != nil {
-		if strings.HasSuffix(err.Error(), "404") {
-			// Job does not exist
+		if isNotFound(err) {
+			// Credentials do not exist
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Could not read ssh credentials: %s", err)
+		return diag.Errorf("Could not read ssh credentials: %s", describeAuthError(err))
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
 	d.Set("scope", cred.Scope)
 	d.Set("description", cred.Description)
-	// NOTE: We are NOT setting the secret here, as the secret returned by GetSingle is garbage
+	// NOTE: We are NOT setting the secret here, as the secret returned by Jenkins is garbage
 	// Secret only applies to Create/Update operations if the "password" property is non-empty
 
 	return nil
 }
 
 func resourceJenkinsCredentialSSHUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	client := meta.(jenkinsClient)
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 
-	domain := d.Get("domain").(string)
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
 
 	cred := jenkins.SSHCredentials{
 		ID:          d.Get("name").(string),
@@ -163,48 +175,34 @@ func resourceJenkinsCredentialSSHUpdate(ctx context.Context, d *schema.ResourceD
 		cred.Passphrase = passphrase
 	}
 
-	err := cm.Update(ctx, domain, d.Get("name").(string), &cred)
+	err := updateCredentials(ctx, client, cm, domain, d.Get("name").(string), &cred)
 	if err != nil {
 		return diag.Errorf("Could not update secret text: %s", err)
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
 	return resourceJenkinsCredentialSSHRead(ctx, d, meta)
 }
 
 func resourceJenkinsCredentialSSHDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	client := meta.(jenkinsClient)
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
 
-	err := cm.Delete(
+	if err := preventDestroyIfInUse(ctx, client, d, cm.Folder, domain); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := deleteCredentials(
 		ctx,
-		d.Get("domain").(string),
+		client,
+		cm,
+		domain,
 		d.Get("name").(string),
 	)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
-
-func resourceJenkinsCredentialSSHImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	ret := []*schema.ResourceData{d}
-
-	splitID := strings.Split(d.Id(), "/")
-	if len(splitID) < 2 {
-		return ret, fmt.Errorf("import ID was improperly formatted. Imports need to be in the format \"[<folder>/]<domain>/<name>\"")
-	}
-
-	name := splitID[len(splitID)-1]
-	d.Set("name", name)
-
-	domain := splitID[len(splitID)-2]
-	d.Set("domain", domain)
-
-	folder := strings.Trim(strings.Join(splitID[0:len(splitID)-2], "/"), "/")
-	d.Set("folder", folder)
-
-	d.SetId(generateCredentialID(folder, name))
-	return ret, nil
-}
@@ -3,7 +3,6 @@ package jenkins
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	jenkins "github.com/bndr/gojenkins"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,8 +16,10 @@ func resourceJenkinsCredentialUsername() *schema.Resource {
 		UpdateContext: resourceJenkinsCredentialUsernameUpdate,
 		DeleteContext: resourceJenkinsCredentialUsernameDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceJenkinsCredentialUsernameImport,
+			StateContext: resourceJenkinsCredentialImport,
 		},
+		CustomizeDiff: resourceJenkinsCredentialCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -30,15 +31,16 @@ func resourceJenkinsCredentialUsername() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The domain namespace that the credentials will be added to.",
 				Optional:    true,
-				Default:     "_",
+				Computed:    true,
 				// In-place updates should be possible, but gojenkins does not support move operations
 				ForceNew: true,
 			},
 			"folder": {
-				Type:        schema.TypeString,
-				Description: "The folder namespace that the credentials will be added to.",
-				Optional:    true,
-				ForceNew:    true,
+				Type:             schema.TypeString,
+				Description:      "The folder namespace that the credentials will be added to.",
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateFolderName,
 			},
 			"scope": {
 				Type:             schema.TypeString,
@@ -53,6 +55,12 @@ func resourceJenkinsCredentialUsername() *schema.Resource {
 				Optional:    true,
 				Default:     "Managed by Terraform",
 			},
+			"prevent_destroy_if_in_use": {
+				Type:        schema.TypeBool,
+				Description: "Whether to refuse to delete the credentials while Jenkins has recorded them as used by any job or node.",
+				Optional:    true,
+				Default:     false,
+			},
 			"username": {
 				Type:        schema.TypeString,
 				Description: "The credentials user username.",
@@ -70,13 +78,15 @@ func resourceJenkinsCredentialUsername() *schema.Resource {
 
 func resourceJenkinsCredentialUsernameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(jenkinsClient)
-	cm := client.Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 
 	// Validate that the folder exists
 	if err := folderExists(ctx, client, cm.Folder); err != nil {
 		return diag.FromErr(fmt.Errorf("invalid folder name '%s' specified: %w", cm.Folder, err))
 	}
+	if err := requirePlugins(ctx, client, credentialsPlugin); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Could not create credentials %q: %w", d.Get("name").(string), err))
+	}
 
 	cred := jenkins.UsernameCredentials{
 		ID:          d.Get("name").(string),
@@ -86,53 +96,55 @@ func resourceJenkinsCredentialUsernameCreate(ctx context.Context, d *schema.Reso
 		Password:    d.Get("password").(string),
 	}
 
-	domain := d.Get("domain").(string)
-	err := cm.Add(ctx, domain, cred)
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
+	d.Set("domain", domain)
+	err := createCredentials(ctx, client, cm, domain, cred)
 	if err != nil {
 		return diag.Errorf("Could not create username credentials: %s", err)
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
-	return resourceJenkinsCredentialUsernameRead(ctx, d, meta)
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
+	return readCreated(ctx, d, meta, resourceJenkinsCredentialUsernameRead)
 }
 
 func resourceJenkinsCredentialUsernameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 
 	cred := jenkins.UsernameCredentials{}
-	err := cm.GetSingle(
+	err := getCredentials(
 		ctx,
-		d.Get("domain").(string),
+		meta.(jenkinsClient),
+		cm,
+		resolveCredentialsDomain(meta, d.Get("domain").(string)),
 		d.Get("name").(string),
 		&cred,
 	)
 
 	if err != nil {
-		if strings.HasSuffix(err.Error(), "404") {
-			// Job does not exist
+		if isNotFound(err) {
+			// Credentials do not exist
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Could not read username credentials: %s", err)
+		return diag.Errorf("Could not read username credentials: %s", describeAuthError(err))
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
 	d.Set("scope", cred.Scope)
 	d.Set("description", cred.Description)
 	d.Set("username", cred.Username)
-	// NOTE: We are NOT setting the password here, as the password returned by GetSingle is garbage
+	// NOTE: We are NOT setting the password here, as the password returned by Jenkins is garbage
 	// Password only applies to Create/Update operations if the "password" property is non-empty
 
 	return nil
 }
 
 func resourceJenkinsCredentialUsernameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	client := meta.(jenkinsClient)
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 
-	domain := d.Get("domain").(string)
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
 	cred := jenkins.UsernameCredentials{
 		ID:          d.Get("name").(string),
 		Scope:       d.Get("scope").(string),
@@ -145,48 +157,34 @@ func resourceJenkinsCredentialUsernameUpdate(ctx context.Context, d *schema.Reso
 		cred.Password = d.Get("password").(string)
 	}
 
-	err := cm.Update(ctx, domain, d.Get("name").(string), &cred)
+	err := updateCredentials(ctx, client, cm, domain, d.Get("name").(string), &cred)
 	if err != nil {
 		return diag.Errorf("Could not update username credentials: %s", err)
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
 	return resourceJenkinsCredentialUsernameRead(ctx, d, meta)
 }
 
 func resourceJenkinsCredentialUsernameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	client := meta.(jenkinsClient)
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
 
-	err := cm.Delete(
+	if err := preventDestroyIfInUse(ctx, client, d, cm.Folder, domain); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := deleteCredentials(
 		ctx,
-		d.Get("domain").(string),
+		client,
+		cm,
+		domain,
 		d.Get("name").(string),
 	)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
-
-func resourceJenkinsCredentialUsernameImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	ret := []*schema.ResourceData{d}
-
-	splitID := strings.Split(d.Id(), "/")
-	if len(splitID) < 2 {
-		return ret, fmt.Errorf("import ID was improperly formatted. Imports need to be in the format \"[<folder>/]<domain>/<name>\"")
-	}
-
-	name := splitID[len(splitID)-1]
-	d.Set("name", name)
-
-	domain := splitID[len(splitID)-2]
-	d.Set("domain", domain)
-
-	folder := strings.Trim(strings.Join(splitID[0:len(splitID)-2], "/"), "/")
-	d.Set("folder", folder)
-
-	d.SetId(generateCredentialID(folder, name))
-	return ret, nil
-}
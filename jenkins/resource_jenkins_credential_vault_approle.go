@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,8 +27,10 @@ func resourceJenkinsCredentialVaultAppRole() *schema.Resource {
 		UpdateContext: resourceJenkinsCredentialVaultAppRoleUpdate,
 		DeleteContext: resourceJenkinsCredentialVaultAppRoleDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceJenkinsCredentialVaultAppRoleImport,
+			StateContext: resourceJenkinsCredentialImport,
 		},
+		CustomizeDiff: resourceJenkinsCredentialCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -41,15 +42,16 @@ func resourceJenkinsCredentialVaultAppRole() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The domain namespace that the credentials will be added to.",
 				Optional:    true,
-				Default:     "_",
+				Computed:    true,
 				// In-place updates should be possible, but gojenkins does not support move operations
 				ForceNew: true,
 			},
 			"folder": {
-				Type:        schema.TypeString,
-				Description: "The folder namespace that the credentials will be added to.",
-				Optional:    true,
-				ForceNew:    true,
+				Type:             schema.TypeString,
+				Description:      "The folder namespace that the credentials will be added to.",
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateFolderName,
 			},
 			"scope": {
 				Type:             schema.TypeString,
@@ -64,6 +66,12 @@ func resourceJenkinsCredentialVaultAppRole() *schema.Resource {
 				Optional:    true,
 				Default:     "Managed by Terraform",
 			},
+			"prevent_destroy_if_in_use": {
+				Type:        schema.TypeBool,
+				Description: "Whether to refuse to delete the credentials while Jenkins has recorded them as used by any job or node.",
+				Optional:    true,
+				Default:     false,
+			},
 			"path": {
 				Type:        schema.TypeString,
 				Description: "Path of the roles approle backend.",
@@ -87,13 +95,15 @@ func resourceJenkinsCredentialVaultAppRole() *schema.Resource {
 
 func resourceJenkinsCredentialVaultAppRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(jenkinsClient)
-	cm := client.Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 	// return diag.FromErr(fmt.Errorf("invalid folder name '%s', '%s'", cm.Folder, d.Get("folder").(string)))
 	// Validate that the folder exists
 	if err := folderExists(ctx, client, cm.Folder); err != nil {
 		return diag.FromErr(fmt.Errorf("invalid folder name '%s' specified: %w", cm.Folder, err))
 	}
+	if err := requirePlugins(ctx, client, credentialsPlugin, vaultCredentialPlugin); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Could not create credentials %q: %w", d.Get("name").(string), err))
+	}
 
 	cred := VaultAppRoleCredentials{
 		ID:          d.Get("name").(string),
@@ -104,54 +114,56 @@ func resourceJenkinsCredentialVaultAppRoleCreate(ctx context.Context, d *schema.
 		SecretID:    d.Get("secret_id").(string),
 	}
 
-	domain := d.Get("domain").(string)
-	err := cm.Add(ctx, domain, cred)
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
+	d.Set("domain", domain)
+	err := createCredentials(ctx, client, cm, domain, cred)
 	if err != nil {
 		return diag.Errorf("Could not create vault approle credentials: %s", err)
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
-	return resourceJenkinsCredentialVaultAppRoleRead(ctx, d, meta)
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
+	return readCreated(ctx, d, meta, resourceJenkinsCredentialVaultAppRoleRead)
 }
 
 func resourceJenkinsCredentialVaultAppRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 
 	cred := VaultAppRoleCredentials{}
-	err := cm.GetSingle(
+	err := getCredentials(
 		ctx,
-		d.Get("domain").(string),
+		meta.(jenkinsClient),
+		cm,
+		resolveCredentialsDomain(meta, d.Get("domain").(string)),
 		d.Get("name").(string),
 		&cred,
 	)
 
 	if err != nil {
-		if strings.HasSuffix(err.Error(), "404") {
-			// Job does not exist
+		if isNotFound(err) {
+			// Credentials do not exist
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Could not read vault approle credentials: %s", err)
+		return diag.Errorf("Could not read vault approle credentials: %s", describeAuthError(err))
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
 	d.Set("scope", cred.Scope)
 	d.Set("description", cred.Description)
 	d.Set("path", cred.Path)
 	d.Set("role_id", cred.RoleID)
-	// NOTE: We are NOT setting the password here, as the password returned by GetSingle is garbage
+	// NOTE: We are NOT setting the password here, as the password returned by Jenkins is garbage
 	// Password only applies to Create/Update operations if the "password" property is non-empty
 
 	return nil
 }
 
 func resourceJenkinsCredentialVaultAppRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	client := meta.(jenkinsClient)
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
 
-	domain := d.Get("domain").(string)
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
 	cred := VaultAppRoleCredentials{
 		ID:          d.Get("name").(string),
 		Scope:       d.Get("scope").(string),
@@ -165,48 +177,34 @@ func resourceJenkinsCredentialVaultAppRoleUpdate(ctx context.Context, d *schema.
 		cred.SecretID = d.Get("secret_id").(string)
 	}
 
-	err := cm.Update(ctx, domain, d.Get("name").(string), &cred)
+	err := updateCredentials(ctx, client, cm, domain, d.Get("name").(string), &cred)
 	if err != nil {
 		return diag.Errorf("Could not update vault approle credentials: %s", err)
 	}
 
-	d.SetId(generateCredentialID(d.Get("folder").(string), cred.ID))
+	d.SetId(generateCredentialID(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)), cred.ID))
 	return resourceJenkinsCredentialVaultAppRoleRead(ctx, d, meta)
 }
 
 func resourceJenkinsCredentialVaultAppRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cm := meta.(jenkinsClient).Credentials()
-	cm.Folder = formatFolderName(d.Get("folder").(string))
+	client := meta.(jenkinsClient)
+	cm := &credentialsManager{Folder: formatFolderName(resolveCredentialsFolder(meta, d.Get("folder").(string), d.Get("scope").(string)))}
+	domain := resolveCredentialsDomain(meta, d.Get("domain").(string))
 
-	err := cm.Delete(
+	if err := preventDestroyIfInUse(ctx, client, d, cm.Folder, domain); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := deleteCredentials(
 		ctx,
-		d.Get("domain").(string),
+		client,
+		cm,
+		domain,
 		d.Get("name").(string),
 	)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
-
-func resourceJenkinsCredentialVaultAppRoleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	ret := []*schema.ResourceData{d}
-
-	splitID := strings.Split(d.Id(), "/")
-	if len(splitID) < 2 {
-		return ret, fmt.Errorf("import ID was improperly formatted. Imports need to be in the format \"[<folder>/]<domain>/<name>\"")
-	}
-
-	name := splitID[len(splitID)-1]
-	d.Set("name", name)
-
-	domain := splitID[len(splitID)-2]
-	d.Set("domain", domain)
-
-	folder := strings.Trim(strings.Join(splitID[0:len(splitID)-2], "/"), "/")
-	d.Set("folder", folder)
-
-	d.SetId(generateCredentialID(folder, name))
-	return ret, nil
-}
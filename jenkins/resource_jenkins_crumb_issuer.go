@@ -0,0 +1,115 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Since Jenkins 2.222 a crumb issuer is set up again on startup, unless disabling CSRF protection has been allowed
+// through a system property.
+const crumbIssuerReadScript = `
+	def issuer = jenkins.model.Jenkins.get().crumbIssuer
+	return [
+		enabled:           issuer != null,
+		exclude_client_ip: issuer instanceof hudson.security.csrf.DefaultCrumbIssuer && issuer.excludeClientIPFromCrumb,
+		disable_allowed:   hudson.security.csrf.GlobalCrumbIssuerConfiguration.DISABLE_CSRF_PROTECTION,
+	]
+`
+
+// crumbIssuerDisableProperty is the system property that keeps Jenkins from enabling CSRF protection on startup.
+const crumbIssuerDisableProperty = "hudson.security.csrf.GlobalCrumbIssuerConfiguration.DISABLE_CSRF_PROTECTION"
+
+const crumbIssuerUpdateScript = `
+	def instance = jenkins.model.Jenkins.get()
+	instance.crumbIssuer = input.enabled ? new hudson.security.csrf.DefaultCrumbIssuer(input.exclude_client_ip) : null
+	instance.save()
+`
+
+func resourceJenkinsCrumbIssuer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsCrumbIssuerCreate,
+		ReadContext:   resourceJenkinsCrumbIssuerRead,
+		UpdateContext: resourceJenkinsCrumbIssuerUpdate,
+		DeleteContext: resourceJenkinsCrumbIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether CSRF protection is enabled.",
+				Optional:    true,
+				Default:     true,
+			},
+			"exclude_client_ip": {
+				Type:        schema.TypeBool,
+				Description: "Whether to exclude the client IP address from crumbs, for compatibility with proxies that vary it.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsCrumbIssuerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "enabled", "exclude_client_ip")
+	if err := executeScript(ctx, client, crumbIssuerUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring crumb issuer: %w", err))
+	}
+
+	d.SetId("crumb-issuer")
+	return resourceJenkinsCrumbIssuerRead(ctx, d, meta)
+}
+
+func resourceJenkinsCrumbIssuerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, crumbIssuerReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading crumb issuer: %w", err))
+	}
+
+	disableAllowed, _ := output["disable_allowed"].(bool)
+	delete(output, "disable_allowed")
+	if diags := setScriptOutput(d, output); diags.HasError() {
+		return diags
+	}
+
+	if enabled, _ := output["enabled"].(bool); !enabled && !disableAllowed {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "CSRF protection will be enabled again on restart",
+			Detail:   fmt.Sprintf("CSRF protection is disabled, but Jenkins enables it again when it restarts unless it is started with the system property -D%s=true. Until that property is set, this resource will show changes after every restart.", crumbIssuerDisableProperty),
+		}}
+	}
+	return nil
+}
+
+func resourceJenkinsCrumbIssuerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "enabled", "exclude_client_ip")
+	if err := executeScript(ctx, client, crumbIssuerUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring crumb issuer: %w", err))
+	}
+
+	return resourceJenkinsCrumbIssuerRead(ctx, d, meta)
+}
+
+func resourceJenkinsCrumbIssuerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Restore the Jenkins default of CSRF protection without proxy compatibility
+	input := map[string]interface{}{"enabled": true, "exclude_client_ip": false}
+	if err := executeScript(ctx, client, crumbIssuerUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring crumb issuer: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsCrumbIssuer_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_crumb_issuer foo {
+				  exclude_client_ip = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_crumb_issuer.foo", "id", "crumb-issuer"),
+					resource.TestCheckResourceAttr("jenkins_crumb_issuer.foo", "enabled", "true"),
+					resource.TestCheckResourceAttr("jenkins_crumb_issuer.foo", "exclude_client_ip", "true"),
+				),
+			},
+			{
+				// Update by reverting the proxy compatibility
+				Config: `
+				resource jenkins_crumb_issuer foo {
+				  exclude_client_ip = false
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_crumb_issuer.foo", "exclude_client_ip", "false"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsCrumbIssuerRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          diag.Diagnostics
+		wantExcludeIP bool
+		wantEnabled   bool
+	}{
+		{
+			name: "proxy compatible",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"enabled":true,"exclude_client_ip":true}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsCrumbIssuer().Schema, map[string]interface{}{}),
+			},
+			wantEnabled:   true,
+			wantExcludeIP: true,
+		},
+		{
+			name: "disabled",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"enabled":false,"exclude_client_ip":false,"disable_allowed":true}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsCrumbIssuer().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "disabled until restart",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"enabled":false,"exclude_client_ip":false,"disable_allowed":false}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsCrumbIssuer().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "CSRF protection will be enabled again on restart",
+				Detail:   "CSRF protection is disabled, but Jenkins enables it again when it restarts unless it is started with the system property -D" + crumbIssuerDisableProperty + "=true. Until that property is set, this resource will show changes after every restart.",
+			}},
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return "", fmt.Errorf("403 Forbidden")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsCrumbIssuer().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::read - Error reading crumb issuer: 403 Forbidden"},
+			},
+			wantEnabled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceJenkinsCrumbIssuerRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsCrumbIssuerRead() = %v, want %v", got, tt.want)
+			}
+			if got := tt.args.d.Get("enabled").(bool); got != tt.wantEnabled {
+				t.Errorf("resourceJenkinsCrumbIssuerRead() enabled = %v, want %v", got, tt.wantEnabled)
+			}
+			if got := tt.args.d.Get("exclude_client_ip").(bool); got != tt.wantExcludeIP {
+				t.Errorf("resourceJenkinsCrumbIssuerRead() exclude_client_ip = %v, want %v", got, tt.wantExcludeIP)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const emailExtReadScript = `
+	def ext = jenkins.model.Jenkins.get().getDescriptorByType(hudson.plugins.emailext.ExtendedEmailPublisherDescriptor)
+	return [
+		default_recipients:                  ext.defaultRecipients ?: '',
+		default_reply_to:                    ext.defaultReplyTo ?: '',
+		default_subject:                     ext.defaultSubject ?: '',
+		default_body:                        ext.defaultBody ?: '',
+		default_content_type:                ext.defaultContentType,
+		default_presend_script:              ext.defaultPresendScript ?: '',
+		default_postsend_script:             ext.defaultPostsendScript ?: '',
+		max_attachment_size_mb:              ext.maxAttachmentSizeMb,
+		admin_required_for_template_testing: ext.adminRequiredForTemplateTesting,
+		watching_enabled:                    ext.watchingEnabled,
+	]
+`
+
+const emailExtUpdateScript = `
+	def ext = jenkins.model.Jenkins.get().getDescriptorByType(hudson.plugins.emailext.ExtendedEmailPublisherDescriptor)
+	ext.defaultRecipients = input.default_recipients
+	ext.defaultReplyTo = input.default_reply_to
+	ext.defaultSubject = input.default_subject
+	ext.defaultBody = input.default_body
+	ext.defaultContentType = input.default_content_type
+	ext.defaultPresendScript = input.default_presend_script
+	ext.defaultPostsendScript = input.default_postsend_script
+	ext.maxAttachmentSizeMb = input.max_attachment_size_mb as long
+	ext.adminRequiredForTemplateTesting = input.admin_required_for_template_testing
+	ext.watchingEnabled = input.watching_enabled
+	ext.save()
+`
+
+var emailExtProperties = []string{
+	"default_recipients",
+	"default_reply_to",
+	"default_subject",
+	"default_body",
+	"default_content_type",
+	"default_presend_script",
+	"default_postsend_script",
+	"max_attachment_size_mb",
+	"admin_required_for_template_testing",
+	"watching_enabled",
+}
+
+func resourceJenkinsEmailExt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsEmailExtCreate,
+		ReadContext:   resourceJenkinsEmailExtRead,
+		UpdateContext: resourceJenkinsEmailExtUpdate,
+		DeleteContext: resourceJenkinsEmailExtDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"default_recipients": {
+				Type:        schema.TypeString,
+				Description: "A comma separated list of recipients that receive every notification by default.",
+				Optional:    true,
+			},
+			"default_reply_to": {
+				Type:        schema.TypeString,
+				Description: "The default Reply-To address of notifications.",
+				Optional:    true,
+			},
+			"default_subject": {
+				Type:        schema.TypeString,
+				Description: "The default subject of notifications, which may contain token macros.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_body": {
+				Type:        schema.TypeString,
+				Description: "The default body of notifications, which may contain token macros.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_content_type": {
+				Type:             schema.TypeString,
+				Description:      "The default content type of notifications, either text/plain or text/html.",
+				Optional:         true,
+				Default:          "text/plain",
+				ValidateDiagFunc: validateEmailContentType,
+			},
+			"default_presend_script": {
+				Type:        schema.TypeString,
+				Description: "A Groovy script run before every notification is sent.",
+				Optional:    true,
+			},
+			"default_postsend_script": {
+				Type:        schema.TypeString,
+				Description: "A Groovy script run after every notification is sent.",
+				Optional:    true,
+			},
+			"max_attachment_size_mb": {
+				Type:        schema.TypeInt,
+				Description: "The maximum total size of attachments in megabytes, or -1 for no limit.",
+				Optional:    true,
+				Default:     -1,
+			},
+			"admin_required_for_template_testing": {
+				Type:        schema.TypeBool,
+				Description: "Whether only administrators may test email templates.",
+				Optional:    true,
+				Default:     false,
+			},
+			"watching_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether users may watch jobs to receive their notifications.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsEmailExtCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, emailExtUpdateScript, scriptInput(d, emailExtProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring extended email: %w", err))
+	}
+
+	d.SetId("email-ext")
+	return resourceJenkinsEmailExtRead(ctx, d, meta)
+}
+
+func resourceJenkinsEmailExtRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, emailExtReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading extended email: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsEmailExtUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, emailExtUpdateScript, scriptInput(d, emailExtProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring extended email: %w", err))
+	}
+
+	return resourceJenkinsEmailExtRead(ctx, d, meta)
+}
+
+func resourceJenkinsEmailExtDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The plugin's own defaults cannot be recovered once overwritten, so the configuration is left in place.
+	log.Printf("[DEBUG] jenkins::delete - Removing extended email configuration %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,87 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsEmailExt_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_email_ext foo {
+				  default_recipients     = "team@example.com"
+				  default_subject        = "$PROJECT_NAME - $BUILD_STATUS"
+				  default_content_type   = "text/html"
+				  max_attachment_size_mb = 10
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_email_ext.foo", "id", "email-ext"),
+					resource.TestCheckResourceAttr("jenkins_email_ext.foo", "default_recipients", "team@example.com"),
+					resource.TestCheckResourceAttr("jenkins_email_ext.foo", "default_subject", "$PROJECT_NAME - $BUILD_STATUS"),
+					resource.TestCheckResourceAttr("jenkins_email_ext.foo", "default_content_type", "text/html"),
+					resource.TestCheckResourceAttr("jenkins_email_ext.foo", "max_attachment_size_mb", "10"),
+					resource.TestCheckResourceAttrSet("jenkins_email_ext.foo", "default_body"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsEmailExtCreate(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name string
+		args args
+		want diag.Diagnostics
+	}{
+		{
+			name: "plugin missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"error":"java.lang.ClassNotFoundException: hudson.plugins.emailext.ExtendedEmailPublisherDescriptor"}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsEmailExt().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::create - Error configuring extended email: script failed: java.lang.ClassNotFoundException: hudson.plugins.emailext.ExtendedEmailPublisherDescriptor"},
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return "", fmt.Errorf("500 Internal Server Error")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsEmailExt().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::create - Error configuring extended email: 500 Internal Server Error"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceJenkinsEmailExtCreate(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsEmailExtCreate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
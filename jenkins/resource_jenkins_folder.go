@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,8 +16,9 @@ func resourceJenkinsFolder() *schema.Resource {
 		UpdateContext: resourceJenkinsFolderUpdate,
 		DeleteContext: resourceJenkinsJobDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceJenkinsJobImport,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -74,12 +74,15 @@ func resourceJenkinsFolder() *schema.Resource {
 func resourceJenkinsFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(jenkinsClient)
 	name := d.Get("name").(string)
-	folderName := d.Get("folder").(string)
+	folderName := resolveFolder(meta, d.Get("folder").(string))
 
 	// Validate that the folder exists
 	if err := folderExists(ctx, client, folderName); err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::create - Could not find folder '%s': %w", folderName, err))
 	}
+	if err := requirePlugins(ctx, client, folderPlugin); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Could not create folder %q: %w", name, err))
+	}
 
 	f := folder{
 		Description: d.Get("description").(string),
@@ -92,15 +95,16 @@ func resourceJenkinsFolderCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	folders := extractFolders(folderName)
-	_, err = client.CreateJobInFolder(ctx, string(xml), name, folders...)
+	err = client.CreateJobInFolder(ctx, string(xml), name, folders...)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::create - Error creating job for %q in folder %s: %w", name, folderName, err))
 	}
 
 	log.Printf("[DEBUG] jenkins::create - job %q created in folder %s", name, folderName)
 	d.SetId(formatFolderName(folderName + "/" + name))
+	folderCacheOf(client).add(d.Id())
 
-	return resourceJenkinsFolderRead(ctx, d, meta)
+	return readCreated(ctx, d, meta, resourceJenkinsFolderRead)
 }
 
 func resourceJenkinsFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -111,17 +115,17 @@ func resourceJenkinsFolderRead(ctx context.Context, d *schema.ResourceData, meta
 
 	job, err := client.GetJob(ctx, name, folders...)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "404") {
+		if isNotFound(err) {
 			// Job does not exist
 			d.SetId("")
 			return nil
 		}
 
-		return diag.FromErr(fmt.Errorf("jenkins::read - Job %q does not exist: %w", name, err))
+		return diag.FromErr(fmt.Errorf("jenkins::read - Job %q could not be read: %w", name, describeAuthError(err)))
 	}
 
 	// Extract the raw XML configuration
-	config, err := job.GetConfig(ctx)
+	config, err := client.GetText(ctx, job.Base+"/config.xml", nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::read - Job %q could not extract configuration: %v", job.Base, err))
 	}
@@ -143,7 +147,7 @@ func resourceJenkinsFolderRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("folder", formatFolderID(folders)); err != nil {
+	if err := d.Set("folder", flattenFolder(meta, d.Get("folder").(string), folders)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -169,7 +173,7 @@ func resourceJenkinsFolderUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// Extract the raw XML configuration
-	config, err := job.GetConfig(ctx)
+	config, err := client.GetText(ctx, job.Base+"/config.xml", nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::update - Job %q could not extract configuration: %v", job.Base, err))
 	}
@@ -190,7 +194,7 @@ func resourceJenkinsFolderUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(fmt.Errorf("jenkins::create - Error binding config.xml template to %q: %w", name, err))
 	}
 
-	err = job.UpdateConfig(ctx, string(xml))
+	err = client.Post(ctx, job.Base+"/config.xml", "application/xml;charset=utf-8", string(xml))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::update - Error updating job %q configuration: %w", name, err))
 	}
@@ -0,0 +1,142 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const gitGlobalConfigReadScript = `
+	def git = jenkins.model.Jenkins.get().getDescriptorByType(hudson.plugins.git.GitSCM.DescriptorImpl)
+	return [
+		user_name:                            git.globalConfigName ?: '',
+		user_email:                           git.globalConfigEmail ?: '',
+		create_account_based_on_email:        git.createAccountBasedOnEmail,
+		use_existing_account_with_same_email: git.useExistingAccountWithSameEmail,
+		show_entire_commit_summary:           git.showEntireCommitSummaryInChanges,
+		hide_credentials:                     git.hideCredentials,
+		add_git_tag_action:                   git.addGitTagAction,
+	]
+`
+
+const gitGlobalConfigUpdateScript = `
+	def git = jenkins.model.Jenkins.get().getDescriptorByType(hudson.plugins.git.GitSCM.DescriptorImpl)
+	git.globalConfigName = input.user_name ?: null
+	git.globalConfigEmail = input.user_email ?: null
+	git.createAccountBasedOnEmail = input.create_account_based_on_email ?: false
+	git.useExistingAccountWithSameEmail = input.use_existing_account_with_same_email ?: false
+	git.showEntireCommitSummaryInChanges = input.show_entire_commit_summary ?: false
+	git.hideCredentials = input.hide_credentials ?: false
+	git.addGitTagAction = input.add_git_tag_action ?: false
+	git.save()
+`
+
+var gitGlobalConfigProperties = []string{
+	"user_name",
+	"user_email",
+	"create_account_based_on_email",
+	"use_existing_account_with_same_email",
+	"show_entire_commit_summary",
+	"hide_credentials",
+	"add_git_tag_action",
+}
+
+func resourceJenkinsGitGlobalConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsGitGlobalConfigCreate,
+		ReadContext:   resourceJenkinsGitGlobalConfigRead,
+		UpdateContext: resourceJenkinsGitGlobalConfigUpdate,
+		DeleteContext: resourceJenkinsGitGlobalConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Description: "The user.name Git uses for commits and tags made by builds.",
+				Optional:    true,
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Description: "The user.email Git uses for commits and tags made by builds.",
+				Optional:    true,
+			},
+			"create_account_based_on_email": {
+				Type:        schema.TypeBool,
+				Description: "Whether Jenkins users are created from commit author emails rather than names.",
+				Optional:    true,
+				Default:     false,
+			},
+			"use_existing_account_with_same_email": {
+				Type:        schema.TypeBool,
+				Description: "Whether commit authors are matched to existing Jenkins users with the same email.",
+				Optional:    true,
+				Default:     false,
+			},
+			"show_entire_commit_summary": {
+				Type:        schema.TypeBool,
+				Description: "Whether the entire commit summary is shown in changes rather than a truncated one.",
+				Optional:    true,
+				Default:     false,
+			},
+			"hide_credentials": {
+				Type:        schema.TypeBool,
+				Description: "Whether credentials are hidden from the Git plugin's console output.",
+				Optional:    true,
+				Default:     false,
+			},
+			"add_git_tag_action": {
+				Type:        schema.TypeBool,
+				Description: "Whether builds get a \"Tag this build\" action.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsGitGlobalConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, gitGlobalConfigUpdateScript, scriptInput(d, gitGlobalConfigProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring Git: %w", err))
+	}
+
+	d.SetId("git-global-config")
+	return resourceJenkinsGitGlobalConfigRead(ctx, d, meta)
+}
+
+func resourceJenkinsGitGlobalConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, gitGlobalConfigReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading Git: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsGitGlobalConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, gitGlobalConfigUpdateScript, scriptInput(d, gitGlobalConfigProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring Git: %w", err))
+	}
+
+	return resourceJenkinsGitGlobalConfigRead(ctx, d, meta)
+}
+
+func resourceJenkinsGitGlobalConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// An empty input clears every setting
+	if err := executeScript(ctx, client, gitGlobalConfigUpdateScript, map[string]interface{}{}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error clearing Git: %w", err))
+	}
+
+	return nil
+}
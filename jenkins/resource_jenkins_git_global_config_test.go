@@ -0,0 +1,69 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsGitGlobalConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_git_global_config foo {
+				  user_name  = "Jenkins"
+				  user_email = "jenkins@example.com"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_git_global_config.foo", "id", "git-global-config"),
+					resource.TestCheckResourceAttr("jenkins_git_global_config.foo", "user_name", "Jenkins"),
+					resource.TestCheckResourceAttr("jenkins_git_global_config.foo", "user_email", "jenkins@example.com"),
+				),
+			},
+			{
+				// Update by matching accounts on email
+				Config: `
+				resource jenkins_git_global_config foo {
+				  user_name                     = "Jenkins"
+				  user_email                    = "jenkins@example.com"
+				  create_account_based_on_email = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_git_global_config.foo", "create_account_based_on_email", "true"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsGitGlobalConfigUpdate(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if strings.Contains(script, "globalConfigName =") && !strings.Contains(script, `"user_email":"jenkins@example.com"`) {
+				t.Errorf("Expected the update script to receive the email: %s", script)
+			}
+			return `{"result":{"user_name":"Jenkins","user_email":"jenkins@example.com","create_account_based_on_email":false,"use_existing_account_with_same_email":false,"show_entire_commit_summary":false,"hide_credentials":false,"add_git_tag_action":false}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsGitGlobalConfig().Schema, map[string]interface{}{
+		"user_name":  "Jenkins",
+		"user_email": "jenkins@example.com",
+	})
+	d.SetId("git-global-config")
+
+	if got := resourceJenkinsGitGlobalConfigUpdate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsGitGlobalConfigUpdate() = %v, want nil", got)
+	}
+	if d.Get("user_name").(string) != "Jenkins" {
+		t.Errorf("Expected user_name %q but received %q", "Jenkins", d.Get("user_name"))
+	}
+}
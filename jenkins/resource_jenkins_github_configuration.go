@@ -0,0 +1,158 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The rate limit strategy belongs to the optional GitHub Branch Source plugin, so its classes are
+// looked up at runtime rather than referenced directly, which would fail the whole script without it.
+const githubConfigurationReadScript = `
+	def instance = jenkins.model.Jenkins.get()
+	def config = instance.getDescriptorByType(org.jenkinsci.plugins.github.config.GitHubPluginConfig)
+	def checker = ''
+	try {
+		def branchSource = instance.pluginManager.uberClassLoader.loadClass('org.jenkinsci.plugins.github_branch_source.GitHubConfiguration')
+		checker = branchSource.get().apiRateLimitChecker.name()
+	} catch (ClassNotFoundException e) {
+	}
+	return [
+		server: config.configs.collect {[
+			name:              it.name ?: '',
+			api_url:           it.apiUrl,
+			credentials_id:    it.credentialsId ?: '',
+			manage_hooks:      it.manageHooks,
+			client_cache_size: it.clientCacheSize,
+		]},
+		api_rate_limit_checker: checker,
+	]
+`
+
+const githubConfigurationUpdateScript = `
+	def instance = jenkins.model.Jenkins.get()
+	def config = instance.getDescriptorByType(org.jenkinsci.plugins.github.config.GitHubPluginConfig)
+	config.configs = (input.server ?: []).collect {
+		def server = new org.jenkinsci.plugins.github.config.GitHubServerConfig(it.credentials_id ?: null)
+		server.name = it.name ?: null
+		server.apiUrl = it.api_url
+		server.manageHooks = it.manage_hooks
+		server.clientCacheSize = it.client_cache_size as int
+		server
+	}
+	config.save()
+
+	if (input.api_rate_limit_checker) {
+		def loader = instance.pluginManager.uberClassLoader
+		def branchSource = loader.loadClass('org.jenkinsci.plugins.github_branch_source.GitHubConfiguration').get()
+		def checkers = loader.loadClass('org.jenkinsci.plugins.github_branch_source.ApiRateLimitChecker')
+		branchSource.apiRateLimitChecker = Enum.valueOf(checkers, input.api_rate_limit_checker)
+		branchSource.save()
+	}
+`
+
+func resourceJenkinsGitHubConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsGitHubConfigurationCreate,
+		ReadContext:   resourceJenkinsGitHubConfigurationRead,
+		UpdateContext: resourceJenkinsGitHubConfigurationUpdate,
+		DeleteContext: resourceJenkinsGitHubConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"server": {
+				Type:        schema.TypeList,
+				Description: "The complete list of GitHub servers.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "An optional name for the server.",
+							Optional:    true,
+						},
+						"api_url": {
+							Type:        schema.TypeString,
+							Description: "The API endpoint of the server, such as https://github.example.com/api/v3 for GitHub Enterprise.",
+							Optional:    true,
+							Default:     "https://api.github.com",
+						},
+						"credentials_id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the secret text credential holding a personal access token.",
+							Optional:    true,
+						},
+						"manage_hooks": {
+							Type:        schema.TypeBool,
+							Description: "Whether Jenkins manages webhooks for repositories on this server.",
+							Optional:    true,
+							Default:     true,
+						},
+						"client_cache_size": {
+							Type:        schema.TypeInt,
+							Description: "The size of the client's HTTP cache in megabytes, or 0 to disable it.",
+							Optional:    true,
+							Default:     20,
+						},
+					},
+				},
+			},
+			"api_rate_limit_checker": {
+				Type:             schema.TypeString,
+				Description:      "The strategy used to stay within GitHub's API rate limit. Requires the GitHub Branch Source plugin.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateGitHubRateLimitChecker,
+			},
+		},
+	}
+}
+
+func resourceJenkinsGitHubConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "server", "api_rate_limit_checker")
+	if err := executeScript(ctx, client, githubConfigurationUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring GitHub: %w", err))
+	}
+
+	d.SetId("github-configuration")
+	return resourceJenkinsGitHubConfigurationRead(ctx, d, meta)
+}
+
+func resourceJenkinsGitHubConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, githubConfigurationReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading GitHub: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsGitHubConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "server", "api_rate_limit_checker")
+	if err := executeScript(ctx, client, githubConfigurationUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring GitHub: %w", err))
+	}
+
+	return resourceJenkinsGitHubConfigurationRead(ctx, d, meta)
+}
+
+func resourceJenkinsGitHubConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Removes every server, leaving the rate limit strategy as it was
+	if err := executeScript(ctx, client, githubConfigurationUpdateScript, map[string]interface{}{}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error clearing GitHub: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsGitHubConfiguration_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_github_configuration foo {
+				  server {
+				    name         = "GitHub"
+				    manage_hooks = false
+				  }
+
+				  api_rate_limit_checker = "ThrottleOnOver"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_github_configuration.foo", "id", "github-configuration"),
+					resource.TestCheckResourceAttr("jenkins_github_configuration.foo", "server.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_github_configuration.foo", "server.0.api_url", "https://api.github.com"),
+					resource.TestCheckResourceAttr("jenkins_github_configuration.foo", "server.0.manage_hooks", "false"),
+					resource.TestCheckResourceAttr("jenkins_github_configuration.foo", "api_rate_limit_checker", "ThrottleOnOver"),
+				),
+			},
+			{
+				// Update by adding a GitHub Enterprise server
+				Config: `
+				resource jenkins_github_configuration foo {
+				  server {
+				    name         = "GitHub"
+				    manage_hooks = false
+				  }
+
+				  server {
+				    name    = "GitHub Enterprise"
+				    api_url = "https://github.example.com/api/v3"
+				  }
+
+				  api_rate_limit_checker = "ThrottleOnOver"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_github_configuration.foo", "server.#", "2"),
+					resource.TestCheckResourceAttr("jenkins_github_configuration.foo", "server.1.api_url", "https://github.example.com/api/v3"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsGitHubConfigurationRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":{"server":[{"name":"","api_url":"https://api.github.com","credentials_id":"github","manage_hooks":true,"client_cache_size":20}],"api_rate_limit_checker":""}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsGitHubConfiguration().Schema, map[string]interface{}{})
+	d.SetId("github-configuration")
+
+	if got := resourceJenkinsGitHubConfigurationRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsGitHubConfigurationRead() = %v, want nil", got)
+	}
+	if d.Get("server.#").(int) != 1 {
+		t.Fatalf("Expected 1 server but received %v", d.Get("server.#"))
+	}
+	if d.Get("server.0.credentials_id").(string) != "github" {
+		t.Errorf("Expected credentials_id %q but received %q", "github", d.Get("server.0.credentials_id"))
+	}
+}
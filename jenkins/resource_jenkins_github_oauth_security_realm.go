@@ -0,0 +1,218 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const githubOAuthSecurityRealmReadScript = `
+	def instance = jenkins.model.Jenkins.get()
+	def realm = instance.securityRealm
+	if (!(realm instanceof org.jenkinsci.plugins.GithubSecurityRealm)) {
+		return null
+	}
+	def result = [
+		github_web_uri: realm.githubWebUri,
+		github_api_uri: realm.githubApiUri,
+		client_id:      realm.clientID,
+		oauth_scopes:   realm.oauthScopes,
+		authorization:  [],
+	]
+	def strategy = instance.authorizationStrategy
+	if (strategy instanceof org.jenkinsci.plugins.GithubAuthorizationStrategy) {
+		def split = { value -> (value ?: '').tokenize(',')*.trim() }
+		result.authorization = [[
+			admin_user_names:              split(strategy.adminUserNames),
+			organization_names:            split(strategy.organizationNames),
+			use_repository_permissions:    strategy.useRepositoryPermissions,
+			authenticated_user_read:       strategy.authenticatedUserReadPermission,
+			authenticated_user_create_job: strategy.authenticatedUserCreateJobPermission,
+			allow_github_webhook:          strategy.allowGithubWebHookPermission,
+			allow_cc_tray:                 strategy.allowCcTrayPermission,
+			allow_anonymous_read:          strategy.allowAnonymousReadPermission,
+			allow_anonymous_job_status:    strategy.allowAnonymousJobStatusPermission,
+		]]
+	}
+	return result
+`
+
+const githubOAuthSecurityRealmUpdateScript = `
+	def instance = jenkins.model.Jenkins.get()
+	instance.securityRealm = new org.jenkinsci.plugins.GithubSecurityRealm(input.github_web_uri, input.github_api_uri, input.client_id, input.client_secret, input.oauth_scopes)
+	input.authorization.each { authorization ->
+		instance.authorizationStrategy = new org.jenkinsci.plugins.GithubAuthorizationStrategy(
+			authorization.admin_user_names.join(','),
+			authorization.authenticated_user_read,
+			authorization.use_repository_permissions,
+			authorization.authenticated_user_create_job,
+			authorization.organization_names.join(','),
+			authorization.allow_github_webhook,
+			authorization.allow_cc_tray,
+			authorization.allow_anonymous_read,
+			authorization.allow_anonymous_job_status
+		)
+	}
+	instance.save()
+`
+
+var githubOAuthSecurityRealmProperties = []string{"github_web_uri", "github_api_uri", "client_id", "client_secret", "oauth_scopes", "authorization"}
+
+func resourceJenkinsGitHubOAuthSecurityRealm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsGitHubOAuthSecurityRealmCreate,
+		ReadContext:   resourceJenkinsGitHubOAuthSecurityRealmRead,
+		UpdateContext: resourceJenkinsGitHubOAuthSecurityRealmUpdate,
+		DeleteContext: resourceJenkinsGitHubOAuthSecurityRealmDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"github_web_uri": {
+				Type:        schema.TypeString,
+				Description: "The web URL of GitHub, or of a GitHub Enterprise server.",
+				Optional:    true,
+				Default:     "https://github.com",
+			},
+			"github_api_uri": {
+				Type:        schema.TypeString,
+				Description: "The API URL of GitHub, or of a GitHub Enterprise server such as \"https://github.example.com/api/v3\".",
+				Optional:    true,
+				Default:     "https://api.github.com",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The client ID of the GitHub OAuth application.",
+				Required:    true,
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "The client secret of the GitHub OAuth application.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"oauth_scopes": {
+				Type:        schema.TypeString,
+				Description: "The comma-separated OAuth scopes to request.",
+				Optional:    true,
+				Default:     "read:org,user:email",
+			},
+			"authorization": {
+				Type:        schema.TypeList,
+				Description: "Authorizes users through their GitHub organizations, replacing the current authorization strategy.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin_user_names": {
+							Type:        schema.TypeList,
+							Description: "The GitHub users and teams granted administrator access.",
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"organization_names": {
+							Type:        schema.TypeList,
+							Description: "The GitHub organizations whose members are granted read access.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"use_repository_permissions": {
+							Type:        schema.TypeBool,
+							Description: "Whether to grant access to jobs based on the permissions of their GitHub repository.",
+							Optional:    true,
+							Default:     true,
+						},
+						"authenticated_user_read": {
+							Type:        schema.TypeBool,
+							Description: "Whether any authenticated user is granted read access.",
+							Optional:    true,
+							Default:     false,
+						},
+						"authenticated_user_create_job": {
+							Type:        schema.TypeBool,
+							Description: "Whether any authenticated user may create jobs.",
+							Optional:    true,
+							Default:     false,
+						},
+						"allow_github_webhook": {
+							Type:        schema.TypeBool,
+							Description: "Whether anonymous requests to the GitHub webhook endpoint are allowed.",
+							Optional:    true,
+							Default:     false,
+						},
+						"allow_cc_tray": {
+							Type:        schema.TypeBool,
+							Description: "Whether anonymous requests to the cc.xml endpoint are allowed.",
+							Optional:    true,
+							Default:     false,
+						},
+						"allow_anonymous_read": {
+							Type:        schema.TypeBool,
+							Description: "Whether anonymous users are granted read access.",
+							Optional:    true,
+							Default:     false,
+						},
+						"allow_anonymous_job_status": {
+							Type:        schema.TypeBool,
+							Description: "Whether anonymous users may view the status of jobs.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceJenkinsGitHubOAuthSecurityRealmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, githubOAuthSecurityRealmProperties...)
+	if err := executeScript(ctx, client, githubOAuthSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring GitHub OAuth security realm: %w", err))
+	}
+
+	d.SetId("github-oauth-security-realm")
+	return resourceJenkinsGitHubOAuthSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsGitHubOAuthSecurityRealmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, githubOAuthSecurityRealmReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading GitHub OAuth security realm: %w", err))
+	}
+
+	if len(output) == 0 {
+		// Jenkins has been switched to another security realm
+		log.Printf("[DEBUG] jenkins::read - Jenkins is no longer using the GitHub OAuth security realm")
+		d.SetId("")
+		return nil
+	}
+
+	// The client secret cannot be read back, so the configured value is kept
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsGitHubOAuthSecurityRealmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, githubOAuthSecurityRealmProperties...)
+	if err := executeScript(ctx, client, githubOAuthSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring GitHub OAuth security realm: %w", err))
+	}
+
+	return resourceJenkinsGitHubOAuthSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsGitHubOAuthSecurityRealmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Falling back to another security realm would lock out every GitHub user, so the realm is left in place.
+	log.Printf("[DEBUG] jenkins::delete - Removing GitHub OAuth security realm %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,51 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// There is no acceptance test, as switching the test instance over to GitHub would lock out the remaining tests.
+
+func Test_resourceJenkinsGitHubOAuthSecurityRealmCreate(t *testing.T) {
+	var scripts []string
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			scripts = append(scripts, script)
+			return `{"result":{"github_web_uri":"https://github.com","github_api_uri":"https://api.github.com","client_id":"abc123","oauth_scopes":"read:org,user:email","authorization":[{"admin_user_names":["octocat","example/admins"],"organization_names":["example"],"use_repository_permissions":true,"authenticated_user_read":false,"authenticated_user_create_job":false,"allow_github_webhook":true,"allow_cc_tray":false,"allow_anonymous_read":false,"allow_anonymous_job_status":false}]}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsGitHubOAuthSecurityRealm().Schema, map[string]interface{}{
+		"client_id":     "abc123",
+		"client_secret": "hunter2",
+		"authorization": []interface{}{
+			map[string]interface{}{
+				"admin_user_names":     []interface{}{"octocat", "example/admins"},
+				"organization_names":   []interface{}{"example"},
+				"allow_github_webhook": true,
+			},
+		},
+	})
+
+	if got := resourceJenkinsGitHubOAuthSecurityRealmCreate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsGitHubOAuthSecurityRealmCreate() = %v, want nil", got)
+	}
+	if d.Id() != "github-oauth-security-realm" {
+		t.Errorf("Expected ID %q but received %q", "github-oauth-security-realm", d.Id())
+	}
+	if len(scripts) != 2 || !strings.Contains(scripts[0], `"admin_user_names":["octocat","example/admins"]`) {
+		t.Errorf("Expected the update script to receive the administrators: %v", scripts)
+	}
+	if d.Get("authorization.0.organization_names.0").(string) != "example" {
+		t.Errorf("Expected organization example but received %v", d.Get("authorization.0.organization_names"))
+	}
+	if d.Get("client_secret").(string) != "hunter2" {
+		t.Errorf("Expected the configured secret to be preserved")
+	}
+}
@@ -0,0 +1,165 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const gitlabConnectionReadScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(com.dabsquared.gitlabjenkins.connection.GitLabConnectionConfig)
+	def connection = config.connections.find { it.name == input.name }
+	if (connection == null) {
+		return null
+	}
+	return [
+		url:                       connection.url,
+		credentials_id:            connection.apiTokenId ?: '',
+		client_builder_id:         connection.clientBuilderId,
+		ignore_certificate_errors: connection.ignoreCertificateErrors,
+		connection_timeout:        connection.connectionTimeout,
+		read_timeout:              connection.readTimeout,
+	]
+`
+
+const gitlabConnectionUpdateScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(com.dabsquared.gitlabjenkins.connection.GitLabConnectionConfig)
+	def connection = new com.dabsquared.gitlabjenkins.connection.GitLabConnection(
+		input.name,
+		input.url,
+		input.credentials_id,
+		input.client_builder_id,
+		input.ignore_certificate_errors,
+		input.connection_timeout as Integer,
+		input.read_timeout as Integer
+	)
+	def connections = config.connections as List
+	def index = connections.findIndexOf { it.name == input.name }
+	if (index < 0) {
+		connections.add(connection)
+	} else {
+		connections[index] = connection
+	}
+	config.connections = connections
+	config.save()
+`
+
+const gitlabConnectionDeleteScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(com.dabsquared.gitlabjenkins.connection.GitLabConnectionConfig)
+	config.connections = config.connections.findAll { it.name != input.name }
+	config.save()
+`
+
+var gitlabConnectionProperties = []string{"name", "url", "credentials_id", "client_builder_id", "ignore_certificate_errors", "connection_timeout", "read_timeout"}
+
+func resourceJenkinsGitLabConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsGitLabConnectionCreate,
+		ReadContext:   resourceJenkinsGitLabConnectionRead,
+		UpdateContext: resourceJenkinsGitLabConnectionUpdate,
+		DeleteContext: resourceJenkinsGitLabConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the connection, as referenced by jobs and the gitlabCommitStatus step.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the GitLab server.",
+				Required:    true,
+			},
+			"credentials_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the GitLab API token credential.",
+				Required:    true,
+			},
+			"client_builder_id": {
+				Type:        schema.TypeString,
+				Description: "The GitLab API version used by the client, such as \"V4\", or \"autodetect\".",
+				Optional:    true,
+				Default:     "autodetect",
+			},
+			"ignore_certificate_errors": {
+				Type:        schema.TypeBool,
+				Description: "Whether TLS certificate errors are ignored when connecting to GitLab.",
+				Optional:    true,
+				Default:     false,
+			},
+			"connection_timeout": {
+				Type:        schema.TypeInt,
+				Description: "The connection timeout in seconds.",
+				Optional:    true,
+				Default:     10,
+			},
+			"read_timeout": {
+				Type:        schema.TypeInt,
+				Description: "The read timeout in seconds.",
+				Optional:    true,
+				Default:     10,
+			},
+		},
+	}
+}
+
+func resourceJenkinsGitLabConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Get("name").(string)
+
+	if err := executeScript(ctx, client, gitlabConnectionUpdateScript, scriptInput(d, gitlabConnectionProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring GitLab connection %q: %w", name, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - GitLab connection %q configured", name)
+	d.SetId(name)
+
+	return resourceJenkinsGitLabConnectionRead(ctx, d, meta)
+}
+
+func resourceJenkinsGitLabConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, gitlabConnectionReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading GitLab connection %q: %w", d.Id(), err))
+	}
+
+	if len(output) == 0 {
+		// GitLab connection does not exist
+		d.SetId("")
+		return nil
+	}
+
+	output["name"] = d.Id()
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsGitLabConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, gitlabConnectionUpdateScript, scriptInput(d, gitlabConnectionProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring GitLab connection %q: %w", d.Id(), err))
+	}
+
+	return resourceJenkinsGitLabConnectionRead(ctx, d, meta)
+}
+
+func resourceJenkinsGitLabConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, gitlabConnectionDeleteScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error removing GitLab connection %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
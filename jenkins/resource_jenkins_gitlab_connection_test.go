@@ -0,0 +1,71 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsGitLabConnection_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_gitlab_connection foo {
+				  name           = "tf-acc-test-%s"
+				  url            = "https://gitlab.example.com"
+				  credentials_id = "gitlab-token"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_gitlab_connection.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_gitlab_connection.foo", "url", "https://gitlab.example.com"),
+					resource.TestCheckResourceAttr("jenkins_gitlab_connection.foo", "client_builder_id", "autodetect"),
+				),
+			},
+			{
+				// Update by raising the timeouts
+				Config: fmt.Sprintf(`
+				resource jenkins_gitlab_connection foo {
+				  name               = "tf-acc-test-%s"
+				  url                = "https://gitlab.example.com"
+				  credentials_id     = "gitlab-token"
+				  connection_timeout = 30
+				  read_timeout       = 30
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_gitlab_connection.foo", "connection_timeout", "30"),
+					resource.TestCheckResourceAttr("jenkins_gitlab_connection.foo", "read_timeout", "30"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsGitLabConnectionDelete(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if !strings.Contains(script, `{"name":"gitlab"}`) {
+				t.Errorf("Expected the delete script to receive the connection name: %s", script)
+			}
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsGitLabConnection().Schema, map[string]interface{}{})
+	d.SetId("gitlab")
+
+	if got := resourceJenkinsGitLabConnectionDelete(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsGitLabConnectionDelete() = %v, want nil", got)
+	}
+}
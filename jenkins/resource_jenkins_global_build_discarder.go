@@ -0,0 +1,143 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const globalBuildDiscarderReadScript = `
+	def strategies = jenkins.model.GlobalBuildDiscarderConfiguration.get().configuredBuildDiscarders
+	def rotator = strategies.find { it instanceof jenkins.model.SimpleGlobalBuildDiscarderStrategy }?.discarder
+	return [
+		job_build_discarder: strategies.any { it instanceof jenkins.model.JobGlobalBuildDiscarderStrategy },
+		default_discarder:   rotator instanceof hudson.tasks.LogRotator ? [[
+			days_to_keep:          rotator.daysToKeep,
+			num_to_keep:           rotator.numToKeep,
+			artifact_days_to_keep: rotator.artifactDaysToKeep,
+			artifact_num_to_keep:  rotator.artifactNumToKeep,
+		]] : [],
+	]
+`
+
+const globalBuildDiscarderUpdateScript = `
+	def config = jenkins.model.GlobalBuildDiscarderConfiguration.get()
+	def strategies = []
+	if (input.job_build_discarder) {
+		strategies.add(new jenkins.model.JobGlobalBuildDiscarderStrategy())
+	}
+	(input.default_discarder ?: []).each {
+		def rotator = new hudson.tasks.LogRotator(
+			it.days_to_keep as int,
+			it.num_to_keep as int,
+			it.artifact_days_to_keep as int,
+			it.artifact_num_to_keep as int
+		)
+		strategies.add(new jenkins.model.SimpleGlobalBuildDiscarderStrategy(rotator))
+	}
+	config.configuredBuildDiscarders.replaceBy(strategies)
+	config.save()
+`
+
+func resourceJenkinsGlobalBuildDiscarder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsGlobalBuildDiscarderCreate,
+		ReadContext:   resourceJenkinsGlobalBuildDiscarderRead,
+		UpdateContext: resourceJenkinsGlobalBuildDiscarderUpdate,
+		DeleteContext: resourceJenkinsGlobalBuildDiscarderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"job_build_discarder": {
+				Type:        schema.TypeBool,
+				Description: "Whether the build discarders configured on individual jobs are applied periodically.",
+				Optional:    true,
+				Default:     true,
+			},
+			"default_discarder": {
+				Type:        schema.TypeList,
+				Description: "A build discarder applied to every job.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_to_keep": {
+							Type:        schema.TypeInt,
+							Description: "The number of days builds are kept for, or -1 to keep them indefinitely.",
+							Optional:    true,
+							Default:     -1,
+						},
+						"num_to_keep": {
+							Type:        schema.TypeInt,
+							Description: "The maximum number of builds kept, or -1 for no limit.",
+							Optional:    true,
+							Default:     -1,
+						},
+						"artifact_days_to_keep": {
+							Type:        schema.TypeInt,
+							Description: "The number of days build artifacts are kept for, or -1 to keep them as long as their build.",
+							Optional:    true,
+							Default:     -1,
+						},
+						"artifact_num_to_keep": {
+							Type:        schema.TypeInt,
+							Description: "The maximum number of builds whose artifacts are kept, or -1 for no limit.",
+							Optional:    true,
+							Default:     -1,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceJenkinsGlobalBuildDiscarderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "job_build_discarder", "default_discarder")
+	if err := executeScript(ctx, client, globalBuildDiscarderUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring global build discarder: %w", err))
+	}
+
+	d.SetId("global-build-discarder")
+	return resourceJenkinsGlobalBuildDiscarderRead(ctx, d, meta)
+}
+
+func resourceJenkinsGlobalBuildDiscarderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, globalBuildDiscarderReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading global build discarder: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsGlobalBuildDiscarderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "job_build_discarder", "default_discarder")
+	if err := executeScript(ctx, client, globalBuildDiscarderUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring global build discarder: %w", err))
+	}
+
+	return resourceJenkinsGlobalBuildDiscarderRead(ctx, d, meta)
+}
+
+func resourceJenkinsGlobalBuildDiscarderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Restore the Jenkins default of only applying the discarders configured on jobs
+	input := map[string]interface{}{"job_build_discarder": true}
+	if err := executeScript(ctx, client, globalBuildDiscarderUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring global build discarder: %w", err))
+	}
+
+	return nil
+}
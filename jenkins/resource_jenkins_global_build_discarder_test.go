@@ -0,0 +1,68 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsGlobalBuildDiscarder_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_global_build_discarder foo {
+				  default_discarder {
+				    num_to_keep = 50
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_global_build_discarder.foo", "id", "global-build-discarder"),
+					resource.TestCheckResourceAttr("jenkins_global_build_discarder.foo", "job_build_discarder", "true"),
+					resource.TestCheckResourceAttr("jenkins_global_build_discarder.foo", "default_discarder.0.num_to_keep", "50"),
+					resource.TestCheckResourceAttr("jenkins_global_build_discarder.foo", "default_discarder.0.days_to_keep", "-1"),
+				),
+			},
+			{
+				// Update by removing the default discarder
+				Config: `
+				resource jenkins_global_build_discarder foo {
+				  job_build_discarder = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_global_build_discarder.foo", "default_discarder.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsGlobalBuildDiscarderRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":{"job_build_discarder":false,"default_discarder":[{"days_to_keep":30,"num_to_keep":-1,"artifact_days_to_keep":-1,"artifact_num_to_keep":5}]}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsGlobalBuildDiscarder().Schema, map[string]interface{}{})
+	d.SetId("global-build-discarder")
+
+	if got := resourceJenkinsGlobalBuildDiscarderRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsGlobalBuildDiscarderRead() = %v, want nil", got)
+	}
+	if d.Get("job_build_discarder").(bool) {
+		t.Errorf("Expected job_build_discarder to be false")
+	}
+	if d.Get("default_discarder.0.days_to_keep").(int) != 30 {
+		t.Errorf("Expected days_to_keep 30 but received %v", d.Get("default_discarder.0.days_to_keep"))
+	}
+	if d.Get("default_discarder.0.artifact_num_to_keep").(int) != 5 {
+		t.Errorf("Expected artifact_num_to_keep 5 but received %v", d.Get("default_discarder.0.artifact_num_to_keep"))
+	}
+}
@@ -0,0 +1,146 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const globalMatrixAuthorizationReadScript = `
+	def strategy = jenkins.model.Jenkins.get().authorizationStrategy
+	if (!(strategy instanceof hudson.security.GlobalMatrixAuthorizationStrategy)) {
+		return null
+	}
+	def grants = [:]
+	strategy.grantedPermissionEntries.each { permission, entries ->
+		entries.each { entry ->
+			def grant = grants.get(entry.type.name() + ':' + entry.sid, [principal: entry.sid, type: entry.type.name(), permissions: []])
+			grant.permissions << permission.id
+		}
+	}
+	return [
+		project_based: strategy instanceof hudson.security.ProjectMatrixAuthorizationStrategy,
+		grant:         grants.values() as List,
+	]
+`
+
+// The new strategy is checked against the provider's own identity before being applied,
+// since a matrix that does not grant it Overall/Administer would lock Terraform out.
+const globalMatrixAuthorizationUpdateScript = `
+	def strategy = input.project_based ? new hudson.security.ProjectMatrixAuthorizationStrategy() : new hudson.security.GlobalMatrixAuthorizationStrategy()
+	input.grant.each { grant ->
+		def entry = new org.jenkinsci.plugins.matrixauth.PermissionEntry(org.jenkinsci.plugins.matrixauth.AuthorizationType.valueOf(grant.type), grant.principal)
+		grant.permissions.each { id ->
+			def permission = hudson.security.Permission.fromId(id)
+			if (permission == null) {
+				throw new IllegalArgumentException("Unknown permission: " + id)
+			}
+			strategy.add(permission, entry)
+		}
+	}
+	if (!strategy.rootACL.hasPermission2(jenkins.model.Jenkins.getAuthentication2(), jenkins.model.Jenkins.ADMINISTER)) {
+		throw new IllegalArgumentException("The authorization matrix does not grant Overall/Administer to " + jenkins.model.Jenkins.getAuthentication2().name)
+	}
+
+	def instance = jenkins.model.Jenkins.get()
+	instance.authorizationStrategy = strategy
+	instance.save()
+`
+
+func resourceJenkinsGlobalMatrixAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsGlobalMatrixAuthorizationCreate,
+		ReadContext:   resourceJenkinsGlobalMatrixAuthorizationRead,
+		UpdateContext: resourceJenkinsGlobalMatrixAuthorizationUpdate,
+		DeleteContext: resourceJenkinsGlobalMatrixAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"project_based": {
+				Type:        schema.TypeBool,
+				Description: "Whether jobs and folders may add their own permissions on top of the global matrix.",
+				Optional:    true,
+				Default:     false,
+			},
+			"grant": {
+				Type:        schema.TypeSet,
+				Description: "The complete set of permissions granted to users and groups.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal": {
+							Type:        schema.TypeString,
+							Description: "The ID of the user or group, or \"anonymous\" or \"authenticated\".",
+							Required:    true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Description:      "Whether the principal is a USER or GROUP, or EITHER for legacy ambiguous entries.",
+							Optional:         true,
+							Default:          "USER",
+							ValidateDiagFunc: validateAuthorizationType,
+						},
+						"permissions": {
+							Type:        schema.TypeSet,
+							Description: "The IDs of the permissions granted, such as \"hudson.model.Hudson.Read\".",
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceJenkinsGlobalMatrixAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "project_based", "grant")
+	if err := executeScript(ctx, client, globalMatrixAuthorizationUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring matrix authorization: %w", err))
+	}
+
+	d.SetId("global-matrix-authorization")
+	return resourceJenkinsGlobalMatrixAuthorizationRead(ctx, d, meta)
+}
+
+func resourceJenkinsGlobalMatrixAuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, globalMatrixAuthorizationReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading matrix authorization: %w", err))
+	}
+
+	if len(output) == 0 {
+		// Jenkins has been switched to another authorization strategy
+		log.Printf("[DEBUG] jenkins::read - Jenkins is no longer using matrix authorization")
+		d.SetId("")
+		return nil
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsGlobalMatrixAuthorizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "project_based", "grant")
+	if err := executeScript(ctx, client, globalMatrixAuthorizationUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring matrix authorization: %w", err))
+	}
+
+	return resourceJenkinsGlobalMatrixAuthorizationRead(ctx, d, meta)
+}
+
+func resourceJenkinsGlobalMatrixAuthorizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no authorization strategy that is safe to fall back to, so the matrix is left in place.
+	log.Printf("[DEBUG] jenkins::delete - Removing matrix authorization %q from state", d.Id())
+	return nil
+}
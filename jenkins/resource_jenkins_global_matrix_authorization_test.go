@@ -0,0 +1,117 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsGlobalMatrixAuthorization_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_global_matrix_authorization foo {
+				  project_based = true
+
+				  grant {
+				    principal   = "admin"
+				    permissions = ["hudson.model.Hudson.Administer"]
+				  }
+
+				  grant {
+				    principal   = "authenticated"
+				    type        = "GROUP"
+				    permissions = ["hudson.model.Hudson.Read", "hudson.model.Item.Read"]
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_global_matrix_authorization.foo", "id", "global-matrix-authorization"),
+					resource.TestCheckResourceAttr("jenkins_global_matrix_authorization.foo", "project_based", "true"),
+					resource.TestCheckResourceAttr("jenkins_global_matrix_authorization.foo", "grant.#", "2"),
+				),
+			},
+			{
+				// Update by revoking item access
+				Config: `
+				resource jenkins_global_matrix_authorization foo {
+				  project_based = true
+
+				  grant {
+				    principal   = "admin"
+				    permissions = ["hudson.model.Hudson.Administer"]
+				  }
+
+				  grant {
+				    principal   = "authenticated"
+				    type        = "GROUP"
+				    permissions = ["hudson.model.Hudson.Read"]
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_global_matrix_authorization.foo", "grant.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsGlobalMatrixAuthorizationRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       diag.Diagnostics
+		wantID     string
+		wantGrants int
+	}{
+		{
+			name: "matrix",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"project_based":false,"grant":[{"principal":"admin","type":"USER","permissions":["hudson.model.Hudson.Administer"]},{"principal":"admins","type":"GROUP","permissions":["hudson.model.Hudson.Administer"]}]}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsGlobalMatrixAuthorization().Schema, map[string]interface{}{}),
+			},
+			wantID:     "global-matrix-authorization",
+			wantGrants: 2,
+		},
+		{
+			name: "other strategy",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsGlobalMatrixAuthorization().Schema, map[string]interface{}{}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("global-matrix-authorization")
+			if got := resourceJenkinsGlobalMatrixAuthorizationRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsGlobalMatrixAuthorizationRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsGlobalMatrixAuthorizationRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+			if got := tt.args.d.Get("grant").(*schema.Set).Len(); got != tt.wantGrants {
+				t.Errorf("resourceJenkinsGlobalMatrixAuthorizationRead() grants = %v, want %v", got, tt.wantGrants)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package jenkins
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceJenkinsGlobalRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createRole("Global", "global role"),
+		ReadContext:   readRole("Global", "global role"),
+		UpdateContext: updateRole("Global", "global role"),
+		DeleteContext: deleteRole("Global", "global role"),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the global role.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the permissions granted by the role, such as \"hudson.model.Hudson.Read\".",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sids": {
+				Type:        schema.TypeSet,
+				Description: "The complete set of users and groups assigned to the role.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
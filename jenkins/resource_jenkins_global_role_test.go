@@ -0,0 +1,119 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsGlobalRole_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_global_role foo {
+				  name        = "tf-acc-test-%s"
+				  permissions = ["hudson.model.Hudson.Read"]
+				  sids        = ["authenticated"]
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_global_role.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_global_role.foo", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_global_role.foo", "sids.#", "1"),
+				),
+			},
+			{
+				// Update by granting further permissions
+				Config: fmt.Sprintf(`
+				resource jenkins_global_role foo {
+				  name        = "tf-acc-test-%s"
+				  permissions = ["hudson.model.Hudson.Read", "hudson.model.Item.Read"]
+				  sids        = ["authenticated", "admin"]
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_global_role.foo", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("jenkins_global_role.foo", "sids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsGlobalRoleRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     diag.Diagnostics
+		wantID   string
+		wantSids int
+	}{
+		{
+			name: "exists",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"permissions":["hudson.model.Hudson.Read"],"sids":["alice","developers"]}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsGlobalRole().Schema, map[string]interface{}{}),
+			},
+			wantID:   "readers",
+			wantSids: 2,
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsGlobalRole().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "not role-based",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"error":"java.lang.IllegalStateException: Jenkins is not using the role-based authorization strategy"}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsGlobalRole().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::read - Error reading global role \"readers\": script failed: java.lang.IllegalStateException: Jenkins is not using the role-based authorization strategy"},
+			},
+			wantID: "readers",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("readers")
+			if got := resourceJenkinsGlobalRole().ReadContext(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsGlobalRoleRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsGlobalRoleRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+			if got := tt.args.d.Get("sids").(*schema.Set).Len(); got != tt.wantSids {
+				t.Errorf("resourceJenkinsGlobalRoleRead() sids = %v, want %v", got, tt.wantSids)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package jenkins
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceJenkinsItemRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createRole("Project", "item role"),
+		ReadContext:   readRole("Project", "item role"),
+		UpdateContext: updateRole("Project", "item role"),
+		DeleteContext: deleteRole("Project", "item role"),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the item role.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"pattern": {
+				Type:        schema.TypeString,
+				Description: "A regular expression matching the full names of the jobs and folders the role applies to.",
+				Required:    true,
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the permissions granted by the role, such as \"hudson.model.Item.Build\".",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sids": {
+				Type:        schema.TypeSet,
+				Description: "The complete set of users and groups assigned to the role.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
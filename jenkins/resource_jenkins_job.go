@@ -3,10 +3,13 @@ package jenkins
 import (
 	"context"
 	"fmt"
+	"html"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,6 +19,11 @@ func resourceJenkinsJob() *schema.Resource {
 		ReadContext:   resourceJenkinsJobRead,
 		UpdateContext: resourceJenkinsJobUpdate,
 		DeleteContext: resourceJenkinsJobDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceJenkinsJobImport,
+		},
+		CustomizeDiff: resourceJenkinsJobCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -43,27 +51,188 @@ func resourceJenkinsJob() *schema.Resource {
 				Optional:    true,
 				Elem:        schema.TypeString,
 			},
+			"verify_config": {
+				Type:        schema.TypeBool,
+				Description: "Whether to fetch the configuration back after applying it, warning about any elements that Jenkins dropped.",
+				Optional:    true,
+				Default:     false,
+			},
+			"credentials_check": {
+				Type:             schema.TypeString,
+				Description:      "Whether to verify that the credentials referenced by credentialsId elements of the configuration exist, either warning after the job is applied (\"warn\") or failing the plan (\"error\").",
+				Optional:         true,
+				Default:          "off",
+				ValidateDiagFunc: validateCredentialsCheck,
+			},
+			"security": {
+				Type:        schema.TypeSet,
+				Description: "The Jenkins project-based security configuration, added to the job's properties.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inheritance_strategy": {
+							Type:        schema.TypeString,
+							Description: "The strategy for applying these permissions sets to existing inherited permissions.",
+							Optional:    true,
+							Default:     "org.jenkinsci.plugins.matrixauth.inheritance.InheritParentStrategy",
+						},
+						"permissions": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The Jenkins permissions sets that provide access to this job.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// jobCredentialsScript lists which of the given credentials are not visible to jobs within a folder.
+// Folders that do not exist yet are checked through their nearest existing parent.
+const jobCredentialsScript = `
+	def path = input.folder
+	def context = path ? jenkins.model.Jenkins.get().getItemByFullName(path) : jenkins.model.Jenkins.get()
+	while (context == null) {
+		path = path.contains('/') ? path.substring(0, path.lastIndexOf('/')) : ''
+		context = path ? jenkins.model.Jenkins.get().getItemByFullName(path) : jenkins.model.Jenkins.get()
+	}
+	def visible = com.cloudbees.plugins.credentials.CredentialsProvider.lookupCredentials(
+		com.cloudbees.plugins.credentials.common.IdCredentials, context, hudson.security.ACL.SYSTEM, []
+	).collect { it.id }
+	return input.ids.findAll { !visible.contains(it) }
+`
+
+var jobCredentialsID = regexp.MustCompile(`<credentialsId>\s*([^<]*?)\s*</credentialsId>`)
+
+// jobCredentialIDs lists the credentials referenced by the credentialsId elements of a job configuration.
+func jobCredentialIDs(xml string) []string {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, match := range jobCredentialsID.FindAllStringSubmatch(xml, -1) {
+		id := html.UnescapeString(match[1])
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// missingJobCredentials lists the credentials referenced by a job configuration that are not visible within its folder.
+func missingJobCredentials(ctx context.Context, client jenkinsClient, folder string, xml string) ([]string, error) {
+	ids := jobCredentialIDs(xml)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	input := map[string]interface{}{"folder": strings.Join(extractFolders(folder), "/"), "ids": ids}
+	missing := []string{}
+	if err := executeScript(ctx, client, jobCredentialsScript, input, &missing); err != nil {
+		return nil, err
+	}
+	return missing, nil
+}
+
+// resourceJenkinsJobCustomizeDiff fails the plan of jobs referencing credentials that do not exist, when asked to.
+// The SDK cannot attach warnings to a plan, so the "warn" check is left until the job is applied.
+func resourceJenkinsJobCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("credentials_check").(string) != "error" || !d.NewValueKnown("template") || !d.NewValueKnown("parameters") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("template") && !d.HasChange("parameters") && !d.HasChange("credentials_check") {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	xml, err := renderTemplate(d.Get("template").(string), d)
+	if err != nil {
+		return fmt.Errorf("jenkins::plan - Error binding config.xml template to %q: %w", name, err)
+	}
+
+	missing, err := missingJobCredentials(ctx, meta.(jenkinsClient), resolveFolder(meta, d.Get("folder").(string)), xml)
+	if err != nil {
+		return fmt.Errorf("jenkins::plan - Could not verify the credentials referenced by job %q: %w", name, err)
+	} else if len(missing) > 0 {
+		return fmt.Errorf("jenkins::plan - Job %q references credentials that do not exist or are not visible to it: %s", name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// jobCredentialsWarnings warns about credentials referenced by an applied job that do not exist, when asked to.
+func jobCredentialsWarnings(ctx context.Context, client jenkinsClient, d *schema.ResourceData, folder string, xml string) diag.Diagnostics {
+	if d.Get("credentials_check").(string) != "warn" {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	missing, err := missingJobCredentials(ctx, client, folder, xml)
+	if err != nil {
+		log.Printf("[DEBUG] jenkins::credentials - Unable to verify the credentials referenced by job %q: %v", name, err)
+		return nil
+	} else if len(missing) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Job references unknown credentials",
+		Detail:   fmt.Sprintf("Job %q references credentials that do not exist or are not visible to it: %s. Builds using them will fail until they are created.", name, strings.Join(missing, ", ")),
+	}}
+}
+
+// jobConfigWarnings warns about elements of an applied job configuration that Jenkins dropped, when asked to.
+// The configuration Jenkins applied is the one just refreshed into the "template" property.
+func jobConfigWarnings(d *schema.ResourceData, submitted string) diag.Diagnostics {
+	if !d.Get("verify_config").(bool) || d.Id() == "" {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	dropped, err := droppedElements(submitted, d.Get("template").(string))
+	if err != nil {
+		log.Printf("[DEBUG] jenkins::xml - Unable to compare the configuration of job %q: %v", name, err)
+		return nil
+	} else if len(dropped) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Job configuration was not fully applied",
+		Detail:   fmt.Sprintf("Jenkins dropped the following elements from the configuration of job %q, which usually means that the plugins providing them are not installed: %s", name, strings.Join(dropped, ", ")),
+	}}
+}
+
 func resourceJenkinsJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(jenkinsClient)
 	name := d.Get("name").(string)
-	folderName := d.Get("folder").(string)
+	folderName := resolveFolder(meta, d.Get("folder").(string))
 
 	// Validate that the folder exists
 	if err := folderExists(ctx, client, folderName); err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::create - Could not find folder '%s': %w", folderName, err))
 	}
 
-	xml, err := renderTemplate(d.Get("template").(string), d)
+	template, err := renderTemplate(d.Get("template").(string), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::create - Error binding config.xml template to %q: %w", name, err))
 	}
 
+	xml, err := bindJobSecurity(template, expandSecurity(d.Get("security").(*schema.Set).List()))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error binding security to %q: %w", name, err))
+	}
+	if err := requirePlugins(ctx, client, xmlPlugins(xml)...); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Could not apply job %q: %w", name, err))
+	}
+
 	folders := extractFolders(folderName)
-	_, err = client.CreateJobInFolder(ctx, xml, name, folders...)
+	err = client.CreateJobInFolder(ctx, xml, name, folders...)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::create - Error creating job for %q in folder %s: %w", name, folderName, err))
 	}
@@ -71,7 +240,12 @@ func resourceJenkinsJobCreate(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("[DEBUG] jenkins::create - job %q created in folder %s", name, folderName)
 	d.SetId(formatFolderName(folderName + "/" + name))
 
-	return resourceJenkinsJobRead(ctx, d, meta)
+	diags := readCreated(ctx, d, meta, resourceJenkinsJobRead)
+	if diags.HasError() {
+		return diags
+	}
+	diags = append(diags, jobConfigWarnings(d, template)...)
+	return append(diags, jobCredentialsWarnings(ctx, client, d, folderName, xml)...)
 }
 
 func resourceJenkinsJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -82,20 +256,34 @@ func resourceJenkinsJobRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	job, err := client.GetJob(ctx, name, folders...)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "404") {
+		if isNotFound(err) {
 			// Job does not exist
 			d.SetId("")
 			return nil
 		}
 
-		return diag.FromErr(fmt.Errorf("jenkins::read - Job %q does not exist: %w", name, err))
+		return diag.FromErr(fmt.Errorf("jenkins::read - Job %q could not be read: %w", name, describeAuthError(err)))
 	}
 
-	config, err := job.GetConfig(ctx)
+	config, err := client.GetText(ctx, job.Base+"/config.xml", nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::read - Job %q could not extract configuration: %v", job.Base, err))
 	}
 
+	// Only separate out the permissions when they are managed through the "security" block,
+	// as they may otherwise be part of the template itself
+	if _, ok := d.GetOk("security"); ok {
+		var security *folderSecurity
+		config, security, err = extractJobSecurity(config)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::read - Job %q could not extract security: %w", job.Base, err))
+		}
+
+		if err := d.Set("security", flattenSecurity(security)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Printf("[DEBUG] jenkins::read - Job %q exists", job.Base)
 	d.SetId(job.Base)
 	if err := d.Set("template", config); err != nil {
@@ -106,7 +294,7 @@ func resourceJenkinsJobRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("folder", formatFolderID(folders)); err != nil {
+	if err := d.Set("folder", flattenFolder(meta, d.Get("folder").(string), folders)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -123,17 +311,30 @@ func resourceJenkinsJobUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("jenkins::update - Could not find job %q: %w", name, err))
 	}
 
-	xml, err := renderTemplate(d.Get("template").(string), d)
+	template, err := renderTemplate(d.Get("template").(string), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::update - Error binding config.xml template to %q: %w", name, err))
 	}
 
-	err = job.UpdateConfig(ctx, xml)
+	xml, err := bindJobSecurity(template, expandSecurity(d.Get("security").(*schema.Set).List()))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error binding security to %q: %w", name, err))
+	}
+	if err := requirePlugins(ctx, client, xmlPlugins(xml)...); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Could not apply job %q: %w", name, err))
+	}
+
+	err = client.Post(ctx, job.Base+"/config.xml", "application/xml;charset=utf-8", xml)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("jenkins::update - Error updating job %q configuration: %w", name, err))
 	}
 
-	return resourceJenkinsJobRead(ctx, d, meta)
+	diags := resourceJenkinsJobRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	diags = append(diags, jobConfigWarnings(d, template)...)
+	return append(diags, jobCredentialsWarnings(ctx, client, d, strings.Join(folders, "/"), xml)...)
 }
 
 func resourceJenkinsJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -143,10 +344,44 @@ func resourceJenkinsJobDelete(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("[DEBUG] jenkins::delete - Removing %q", name)
 
 	ok, err := client.DeleteJobInFolder(ctx, name, folders...)
-	if err != nil {
+	if isNotFound(err) {
+		// The job, or the folder it was in, has already been removed
+		log.Printf("[DEBUG] jenkins::delete - %q was already removed", name)
+		return nil
+	} else if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// Jenkins keeps the item around until its running builds have been aborted, and replacing it
+	// is only possible once it is gone
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.GetJob(ctx, name, folders...)
+		if isNotFound(err) {
+			return nil
+		} else if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		log.Printf("[DEBUG] jenkins::delete - Waiting for %q to be removed", name)
+		return resource.RetryableError(fmt.Errorf("%q is still being removed", name))
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error waiting for %q to be removed: %w", name, err))
+	}
+
 	log.Printf("[DEBUG] jenkins::delete - %q removed: %t", name, ok)
 	return nil
 }
+
+// resourceJenkinsJobImport imports jobs and folders, given by their path, canonical name or URL in Jenkins.
+func resourceJenkinsJobImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{d}
+
+	folders, segments, err := parseImportID(d.Id(), 1)
+	if err != nil {
+		return ret, fmt.Errorf("import ID was improperly formatted (%v). Imports need to be in the format \"[<folder>/]<name>\" or the URL of the item", err)
+	}
+
+	d.Set("folder", importFolder(m, folders))
+	d.SetId(formatFolderName(strings.Join(append(folders, segments[0]), "/")))
+	return ret, nil
+}
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"reflect"
 	"testing"
 
-	jenkins "github.com/bndr/gojenkins"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -84,6 +84,40 @@ func TestAccJenkinsJob_nested(t *testing.T) {
 	})
 }
 
+func TestAccJenkinsJob_security(t *testing.T) {
+	xml, _ := ioutil.ReadFile("resource_jenkins_job_test.xml")
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJenkinsJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_job foo {
+				  name = "tf-acc-test-%s"
+				  template = <<EOT
+				`+string(xml)+`
+				EOT
+
+				  parameters = {
+					  description = "Acceptance testing Jenkins provider"
+				  }
+
+				  security {
+					  permissions = ["hudson.model.Item.Build:deployer"]
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_job.foo", "id", "/job/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_job.foo", "security.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckJenkinsJobDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(jenkinsClient)
 	ctx := context.Background()
@@ -116,10 +150,46 @@ func Test_resourceJenkinsJobDelete(t *testing.T) {
 		{
 			name: "success",
 			args: args{
+				ctx: context.Background(),
 				meta: &mockJenkinsClient{
 					mockDeleteJobInFolder: func(ctx context.Context, name string, parentIDs ...string) (bool, error) {
 						return true, nil
 					},
+					mockGetJob: func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
+						return nil, newStatusError(http.StatusNotFound, "")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "still-removing",
+			args: args{
+				ctx: context.Background(),
+				meta: &mockJenkinsClient{
+					mockDeleteJobInFolder: func(ctx context.Context, name string, parentIDs ...string) (bool, error) {
+						return true, nil
+					},
+					mockGetJob: func() func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
+						polls := 0
+						return func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
+							if polls++; polls < 2 {
+								return &jobItem{}, nil
+							}
+							return nil, newStatusError(http.StatusNotFound, "")
+						}
+					}(),
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "already-removed",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockDeleteJobInFolder: func(ctx context.Context, name string, parentIDs ...string) (bool, error) {
+						return false, newStatusError(http.StatusNotFound, "")
+					},
 				},
 				d: schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{}),
 			},
@@ -163,25 +233,50 @@ func Test_resourceJenkinsJobRead(t *testing.T) {
 			name: "missing-job",
 			args: args{
 				meta: &mockJenkinsClient{
-					mockGetJob: func(ctx context.Context, id string, parentIDs ...string) (*jenkins.Job, error) {
-						return nil, fmt.Errorf("404")
+					mockGetJob: func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
+						return nil, newStatusError(http.StatusNotFound, "")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "deleted-job",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockGetJob: func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
+						return nil, fmt.Errorf("could not poll job: %w", newStatusError(http.StatusGone, ""))
 					},
 				},
 				d: schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{}),
 			},
 		},
+		{
+			name: "forbidden-job",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockGetJob: func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
+						return nil, newStatusError(http.StatusForbidden, "")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::read - Job \"\" could not be read: 403 Forbidden: the provider's user is not permitted to access this item"},
+			},
+		},
 		{
 			name: "error-job",
 			args: args{
 				meta: &mockJenkinsClient{
-					mockGetJob: func(ctx context.Context, id string, parentIDs ...string) (*jenkins.Job, error) {
+					mockGetJob: func(ctx context.Context, id string, parentIDs ...string) (*jobItem, error) {
 						return nil, fmt.Errorf("500")
 					},
 				},
 				d: schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{}),
 			},
 			want: diag.Diagnostics{
-				diag.Diagnostic{Summary: "jenkins::read - Job \"\" does not exist: 500"},
+				diag.Diagnostic{Summary: "jenkins::read - Job \"\" could not be read: 500"},
 			},
 		},
 	}
@@ -193,3 +288,73 @@ func Test_resourceJenkinsJobRead(t *testing.T) {
 		})
 	}
 }
+
+func Test_resourceJenkinsJobImport(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{})
+	d.SetId("https://ci.example.com/ci/job/team/job/builds/job/deploy/")
+
+	if _, err := resourceJenkinsJobImport(context.Background(), d, &mockJenkinsClient{}); err != nil {
+		t.Fatalf("Expected the import to succeed but received: %s", err)
+	}
+	if d.Id() != "team/job/builds/job/deploy" || d.Get("folder") != "team/job/builds" {
+		t.Errorf("Expected the job to be located but received %q in %q", d.Id(), d.Get("folder"))
+	}
+}
+
+func Test_jobCredentialIDs(t *testing.T) {
+	config := `<flow-definition>
+  <scm class="hudson.plugins.git.GitSCM">
+    <userRemoteConfigs><hudson.plugins.git.UserRemoteConfig><credentialsId>git-deploy</credentialsId></hudson.plugins.git.UserRemoteConfig></userRemoteConfigs>
+  </scm>
+  <credentialsId> docker&amp;registry </credentialsId>
+  <credentialsId>git-deploy</credentialsId>
+  <credentialsId></credentialsId>
+</flow-definition>`
+
+	expected := []string{"git-deploy", "docker&registry"}
+	if actual := jobCredentialIDs(config); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v to be referenced but received %v", expected, actual)
+	}
+}
+
+func Test_jobCredentialsWarnings(t *testing.T) {
+	client := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":["docker-registry"]}`, nil
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{
+		"name":              "deploy",
+		"credentials_check": "warn",
+	})
+	config := "<project><credentialsId>docker-registry</credentialsId></project>"
+
+	diags := jobCredentialsWarnings(context.Background(), client, d, "team", config)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("Expected the missing credentials to be warned about but received %v", diags)
+	}
+
+	// Jobs are not checked unless asked to
+	d.Set("credentials_check", "off")
+	if diags := jobCredentialsWarnings(context.Background(), client, d, "team", config); len(diags) != 0 {
+		t.Errorf("Expected no warnings but received %v", diags)
+	}
+}
+
+func Test_jobConfigWarnings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceJenkinsJob().Schema, map[string]interface{}{
+		"name":          "build",
+		"verify_config": true,
+		"template":      "<project><builders/></project>",
+	})
+	d.SetId("build")
+
+	diags := jobConfigWarnings(d, "<project><builders><org.example.MissingBuilder/></builders></project>")
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("Expected the dropped element to be warned about but received %v", diags)
+	}
+
+	if diags := jobConfigWarnings(d, "<project><builders/></project>"); len(diags) != 0 {
+		t.Errorf("Expected no warnings but received %v", diags)
+	}
+}
@@ -0,0 +1,231 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const ldapSecurityRealmReadScript = `
+	def realm = jenkins.model.Jenkins.get().securityRealm
+	if (!(realm instanceof hudson.security.LDAPSecurityRealm)) {
+		return null
+	}
+	if (realm.configurations.size() > 1) {
+		throw new IllegalStateException("the LDAP security realm has " + realm.configurations.size() + " server configurations, but only one can be managed")
+	}
+	def config = realm.configurations[0]
+	def membership = config.groupMembershipStrategy
+	return [
+		server:                        config.server,
+		root_dn:                       config.rootDN ?: '',
+		inhibit_infer_root_dn:         config.inhibitInferRootDN,
+		user_search_base:              config.userSearchBase ?: '',
+		user_search:                   config.userSearch,
+		group_search_base:             config.groupSearchBase ?: '',
+		group_search_filter:           config.groupSearchFilter ?: '',
+		group_membership_filter:       membership instanceof jenkins.security.plugins.ldap.FromGroupSearchLDAPGroupMembershipStrategy ? (membership.filter ?: '') : '',
+		group_membership_attribute:    membership instanceof jenkins.security.plugins.ldap.FromUserRecordLDAPGroupMembershipStrategy ? membership.attributeName : '',
+		manager_dn:                    config.managerDN ?: '',
+		display_name_attribute:        config.displayNameAttributeName,
+		mail_address_attribute:        config.mailAddressAttributeName,
+		disable_mail_address_resolver: realm.disableMailAddressResolver,
+		cache_size:                    realm.cache?.size ?: 0,
+		cache_ttl:                     realm.cache?.ttl ?: 300,
+	]
+`
+
+// The ID strategies and, unless a new one is given, the manager password are kept from the current realm, as neither
+// can be read back and changing the ID strategies changes which users and groups existing permissions match.
+const ldapSecurityRealmUpdateScript = `
+	def instance = jenkins.model.Jenkins.get()
+	def current = instance.securityRealm
+	def managerPassword = hudson.util.Secret.fromString(input.manager_password)
+	if (current instanceof hudson.security.LDAPSecurityRealm) {
+		if (current.configurations.size() > 1) {
+			throw new IllegalStateException("the LDAP security realm has " + current.configurations.size() + " server configurations, but only one can be managed")
+		}
+		if (!input.manager_password) {
+			managerPassword = current.configurations[0].managerPasswordSecret
+		}
+	}
+
+	def config = new jenkins.security.plugins.ldap.LDAPConfiguration(input.server, input.root_dn, input.inhibit_infer_root_dn, input.manager_dn, managerPassword)
+	config.userSearchBase = input.user_search_base
+	config.userSearch = input.user_search
+	config.groupSearchBase = input.group_search_base
+	config.groupSearchFilter = input.group_search_filter
+	config.groupMembershipStrategy = input.group_membership_attribute ?
+		new jenkins.security.plugins.ldap.FromUserRecordLDAPGroupMembershipStrategy(input.group_membership_attribute) :
+		new jenkins.security.plugins.ldap.FromGroupSearchLDAPGroupMembershipStrategy(input.group_membership_filter)
+	config.displayNameAttributeName = input.display_name_attribute
+	config.mailAddressAttributeName = input.mail_address_attribute
+
+	def cache = input.cache_size > 0 ? new hudson.security.LDAPSecurityRealm.CacheConfiguration(input.cache_size, input.cache_ttl) : null
+	def realm = new hudson.security.LDAPSecurityRealm([config], input.disable_mail_address_resolver, cache, current.userIdStrategy, current.groupIdStrategy)
+
+	instance.securityRealm = realm
+	instance.save()
+`
+
+var ldapSecurityRealmProperties = []string{
+	"server", "root_dn", "inhibit_infer_root_dn", "user_search_base", "user_search", "group_search_base", "group_search_filter",
+	"group_membership_filter", "group_membership_attribute", "manager_dn", "manager_password", "display_name_attribute",
+	"mail_address_attribute", "disable_mail_address_resolver", "cache_size", "cache_ttl",
+}
+
+func resourceJenkinsLDAPSecurityRealm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsLDAPSecurityRealmCreate,
+		ReadContext:   resourceJenkinsLDAPSecurityRealmRead,
+		UpdateContext: resourceJenkinsLDAPSecurityRealmUpdate,
+		DeleteContext: resourceJenkinsLDAPSecurityRealmDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"server": {
+				Type:        schema.TypeString,
+				Description: "The LDAP server to connect to, such as \"ldaps://ldap.example.com:636\".",
+				Required:    true,
+			},
+			"root_dn": {
+				Type:        schema.TypeString,
+				Description: "The DN of the root of the directory. Inferred from the server when left unset.",
+				Optional:    true,
+			},
+			"inhibit_infer_root_dn": {
+				Type:        schema.TypeBool,
+				Description: "Whether to stop the root DN from being inferred from the server.",
+				Optional:    true,
+				Default:     false,
+			},
+			"user_search_base": {
+				Type:        schema.TypeString,
+				Description: "The base DN to search for users from, relative to the root DN.",
+				Optional:    true,
+			},
+			"user_search": {
+				Type:        schema.TypeString,
+				Description: "The filter used to find users, where {0} is the username.",
+				Optional:    true,
+				Default:     "uid={0}",
+			},
+			"group_search_base": {
+				Type:        schema.TypeString,
+				Description: "The base DN to search for groups from, relative to the root DN.",
+				Optional:    true,
+			},
+			"group_search_filter": {
+				Type:        schema.TypeString,
+				Description: "The filter used to find groups by name, where {0} is the group name.",
+				Optional:    true,
+			},
+			"group_membership_filter": {
+				Type:          schema.TypeString,
+				Description:   "The filter used to find the groups of a user by searching the groups.",
+				Optional:      true,
+				ConflictsWith: []string{"group_membership_attribute"},
+			},
+			"group_membership_attribute": {
+				Type:          schema.TypeString,
+				Description:   "The attribute of the user record listing the groups of the user, such as \"memberOf\".",
+				Optional:      true,
+				ConflictsWith: []string{"group_membership_filter"},
+			},
+			"manager_dn": {
+				Type:        schema.TypeString,
+				Description: "The DN to bind to the server with when searching. Binds anonymously when left unset.",
+				Optional:    true,
+			},
+			"manager_password": {
+				Type:        schema.TypeString,
+				Description: "The password of the manager DN. The current password is kept when left unset.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"display_name_attribute": {
+				Type:        schema.TypeString,
+				Description: "The attribute of the user record holding the display name.",
+				Optional:    true,
+				Default:     "displayname",
+			},
+			"mail_address_attribute": {
+				Type:        schema.TypeString,
+				Description: "The attribute of the user record holding the email address.",
+				Optional:    true,
+				Default:     "mail",
+			},
+			"disable_mail_address_resolver": {
+				Type:        schema.TypeBool,
+				Description: "Whether to stop email addresses from being resolved through LDAP.",
+				Optional:    true,
+				Default:     false,
+			},
+			"cache_size": {
+				Type:        schema.TypeInt,
+				Description: "The number of users and groups to cache. Caching is disabled when set to 0.",
+				Optional:    true,
+				Default:     0,
+			},
+			"cache_ttl": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds users and groups are cached for.",
+				Optional:    true,
+				Default:     300,
+			},
+		},
+	}
+}
+
+func resourceJenkinsLDAPSecurityRealmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, ldapSecurityRealmProperties...)
+	if err := executeScript(ctx, client, ldapSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring LDAP security realm: %w", err))
+	}
+
+	d.SetId("ldap-security-realm")
+	return resourceJenkinsLDAPSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsLDAPSecurityRealmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, ldapSecurityRealmReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading LDAP security realm: %w", err))
+	}
+
+	if len(output) == 0 {
+		// Jenkins has been switched to another security realm
+		log.Printf("[DEBUG] jenkins::read - Jenkins is no longer using the LDAP security realm")
+		d.SetId("")
+		return nil
+	}
+
+	// The manager password cannot be read back, so the configured value is kept
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsLDAPSecurityRealmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, ldapSecurityRealmProperties...)
+	if err := executeScript(ctx, client, ldapSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring LDAP security realm: %w", err))
+	}
+
+	return resourceJenkinsLDAPSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsLDAPSecurityRealmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Falling back to another security realm would lock out every LDAP user, so the realm is left in place.
+	log.Printf("[DEBUG] jenkins::delete - Removing LDAP security realm %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,110 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// There is no acceptance test, as switching the test instance over to LDAP would lock out the remaining tests.
+
+func Test_resourceJenkinsLDAPSecurityRealmRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":{"server":"ldap://ldap.example.com","root_dn":"dc=example,dc=com","inhibit_infer_root_dn":false,"user_search_base":"","user_search":"uid={0}","group_search_base":"","group_search_filter":"","group_membership_filter":"","group_membership_attribute":"memberOf","manager_dn":"cn=jenkins","display_name_attribute":"displayname","mail_address_attribute":"mail","disable_mail_address_resolver":false,"cache_size":0,"cache_ttl":300}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsLDAPSecurityRealm().Schema, map[string]interface{}{
+		"server":           "ldap://ldap.example.com",
+		"manager_password": "hunter2",
+	})
+	d.SetId("ldap-security-realm")
+
+	if got := resourceJenkinsLDAPSecurityRealmRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsLDAPSecurityRealmRead() = %v, want nil", got)
+	}
+	if d.Get("group_membership_attribute").(string) != "memberOf" {
+		t.Errorf("Expected group_membership_attribute memberOf but received %v", d.Get("group_membership_attribute"))
+	}
+	if d.Get("manager_password").(string) != "hunter2" {
+		t.Errorf("Expected the configured password to be preserved")
+	}
+}
+
+func Test_resourceJenkinsLDAPSecurityRealmRead_multipleServers(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if !strings.Contains(script, "realm.configurations.size() > 1") {
+				t.Errorf("Expected realms with several server configurations to be rejected: %s", script)
+			}
+			return `{"error":"java.lang.IllegalStateException: the LDAP security realm has 2 server configurations, but only one can be managed"}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsLDAPSecurityRealm().Schema, map[string]interface{}{
+		"server": "ldap://ldap.example.com",
+	})
+	d.SetId("ldap-security-realm")
+
+	want := diag.Diagnostics{
+		diag.Diagnostic{Summary: "jenkins::read - Error reading LDAP security realm: script failed: java.lang.IllegalStateException: the LDAP security realm has 2 server configurations, but only one can be managed"},
+	}
+	if got := resourceJenkinsLDAPSecurityRealmRead(context.Background(), d, meta); !reflect.DeepEqual(got, want) {
+		t.Errorf("resourceJenkinsLDAPSecurityRealmRead() = %v, want %v", got, want)
+	}
+	if d.Id() != "ldap-security-realm" {
+		t.Errorf("Expected the realm to be kept in state")
+	}
+}
+
+func Test_resourceJenkinsLDAPSecurityRealmUpdate(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if strings.Contains(script, "LDAPConfiguration(") {
+				if strings.Contains(script, "IdStrategy.CASE_INSENSITIVE") || !strings.Contains(script, "current.userIdStrategy, current.groupIdStrategy") {
+					t.Errorf("Expected the ID strategies of the current realm to be kept: %s", script)
+				}
+				if !strings.Contains(script, "managerPassword = current.configurations[0].managerPasswordSecret") {
+					t.Errorf("Expected the current manager password to be kept when none is given: %s", script)
+				}
+				return `{"result":null}`, nil
+			}
+			return `{"result":{"server":"ldap://ldap.example.com","root_dn":"","inhibit_infer_root_dn":false,"user_search_base":"","user_search":"uid={0}","group_search_base":"","group_search_filter":"","group_membership_filter":"","group_membership_attribute":"","manager_dn":"cn=jenkins","display_name_attribute":"displayname","mail_address_attribute":"mail","disable_mail_address_resolver":false,"cache_size":0,"cache_ttl":300}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsLDAPSecurityRealm().Schema, map[string]interface{}{
+		"server":     "ldap://ldap.example.com",
+		"manager_dn": "cn=jenkins",
+	})
+	d.SetId("ldap-security-realm")
+
+	if got := resourceJenkinsLDAPSecurityRealmUpdate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsLDAPSecurityRealmUpdate() = %v, want nil", got)
+	}
+}
+
+func Test_resourceJenkinsLDAPSecurityRealmDelete(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if strings.Contains(script, "securityRealm") {
+				t.Errorf("Expected the security realm to be left in place: %s", script)
+			}
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsLDAPSecurityRealm().Schema, map[string]interface{}{
+		"server": "ldap://ldap.example.com",
+	})
+	d.SetId("ldap-security-realm")
+
+	if got := resourceJenkinsLDAPSecurityRealmDelete(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsLDAPSecurityRealmDelete() = %v, want nil", got)
+	}
+}
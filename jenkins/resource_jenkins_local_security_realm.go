@@ -0,0 +1,117 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const localSecurityRealmReadScript = `
+	def realm = jenkins.model.Jenkins.get().securityRealm
+	if (!(realm instanceof hudson.security.HudsonPrivateSecurityRealm)) {
+		return null
+	}
+	return [
+		allow_signup:   realm.allowsSignup(),
+		enable_captcha: realm.isEnableCaptcha(),
+	]
+`
+
+// The realm is replaced rather than modified, which keeps the user database intact as it is not held by the realm.
+const localSecurityRealmUpdateScript = `
+	def instance = jenkins.model.Jenkins.get()
+	def realm = instance.securityRealm
+	if (!(realm instanceof hudson.security.HudsonPrivateSecurityRealm)) {
+		if (input.required) {
+			throw new IllegalStateException('Jenkins is not using its own user database')
+		}
+		return null
+	}
+	instance.securityRealm = new hudson.security.HudsonPrivateSecurityRealm(input.allow_signup, input.enable_captcha, realm.captchaSupport)
+	instance.save()
+`
+
+func resourceJenkinsLocalSecurityRealm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsLocalSecurityRealmCreate,
+		ReadContext:   resourceJenkinsLocalSecurityRealmRead,
+		UpdateContext: resourceJenkinsLocalSecurityRealmUpdate,
+		DeleteContext: resourceJenkinsLocalSecurityRealmDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"allow_signup": {
+				Type:        schema.TypeBool,
+				Description: "Whether visitors may create their own accounts.",
+				Optional:    true,
+				Default:     false,
+			},
+			"enable_captcha": {
+				Type:        schema.TypeBool,
+				Description: "Whether a captcha must be solved to sign up.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsLocalSecurityRealmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "allow_signup", "enable_captcha")
+	input["required"] = true
+	if err := executeScript(ctx, client, localSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring local security realm: %w", err))
+	}
+
+	d.SetId("local-security-realm")
+	return resourceJenkinsLocalSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsLocalSecurityRealmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, localSecurityRealmReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading local security realm: %w", err))
+	}
+
+	if len(output) == 0 {
+		// Jenkins has been switched to another security realm
+		log.Printf("[DEBUG] jenkins::read - Jenkins is no longer using its own user database")
+		d.SetId("")
+		return nil
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsLocalSecurityRealmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "allow_signup", "enable_captcha")
+	input["required"] = true
+	if err := executeScript(ctx, client, localSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring local security realm: %w", err))
+	}
+
+	return resourceJenkinsLocalSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsLocalSecurityRealmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Restore the Jenkins defaults of signup being disabled, unless another realm has since taken over
+	input := map[string]interface{}{"allow_signup": false, "enable_captcha": false, "required": false}
+	if err := executeScript(ctx, client, localSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring local security realm: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsLocalSecurityRealm_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_local_security_realm foo {
+				  allow_signup = false
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_local_security_realm.foo", "id", "local-security-realm"),
+					resource.TestCheckResourceAttr("jenkins_local_security_realm.foo", "allow_signup", "false"),
+					resource.TestCheckResourceAttr("jenkins_local_security_realm.foo", "enable_captcha", "false"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsLocalSecurityRealmRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       diag.Diagnostics
+		wantID     string
+		wantSignup bool
+	}{
+		{
+			name: "local",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"allow_signup":true,"enable_captcha":false}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsLocalSecurityRealm().Schema, map[string]interface{}{}),
+			},
+			wantID:     "local-security-realm",
+			wantSignup: true,
+		},
+		{
+			name: "other realm",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsLocalSecurityRealm().Schema, map[string]interface{}{}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("local-security-realm")
+			if got := resourceJenkinsLocalSecurityRealmRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsLocalSecurityRealmRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsLocalSecurityRealmRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+			if got := tt.args.d.Get("allow_signup").(bool); got != tt.wantSignup {
+				t.Errorf("resourceJenkinsLocalSecurityRealmRead() allow_signup = %v, want %v", got, tt.wantSignup)
+			}
+		})
+	}
+}
+
+func Test_resourceJenkinsLocalSecurityRealmDelete(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if !strings.Contains(script, `"allow_signup":false`) || !strings.Contains(script, `"required":false`) {
+				t.Errorf("Expected signup to be disabled if the realm is still in use: %s", script)
+			}
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsLocalSecurityRealm().Schema, map[string]interface{}{
+		"allow_signup": true,
+	})
+	d.SetId("local-security-realm")
+
+	if got := resourceJenkinsLocalSecurityRealmDelete(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsLocalSecurityRealmDelete() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,138 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const lockableResourceReadScript = `
+	def lockable = org.jenkins.plugins.lockableresources.LockableResourcesManager.get().fromName(input.name)
+	if (lockable == null || lockable.ephemeral) {
+		return null
+	}
+	return [
+		description: lockable.description ?: '',
+		labels:      (lockable.labels ?: '').tokenize(' '),
+		reserved_by: lockable.reservedBy ?: '',
+	]
+`
+
+const lockableResourceUpdateScript = `
+	def manager = org.jenkins.plugins.lockableresources.LockableResourcesManager.get()
+	def lockable = manager.fromName(input.name)
+	if (lockable == null) {
+		manager.createResource(input.name)
+		lockable = manager.fromName(input.name)
+	}
+	lockable.ephemeral = false
+	lockable.description = input.description
+	lockable.labels = input.labels.join(' ')
+	lockable.reservedBy = input.reserved_by ?: null
+	manager.save()
+`
+
+const lockableResourceDeleteScript = `
+	def manager = org.jenkins.plugins.lockableresources.LockableResourcesManager.get()
+	def lockable = manager.fromName(input.name)
+	if (lockable != null) {
+		manager.resources.remove(lockable)
+		manager.save()
+	}
+`
+
+func resourceJenkinsLockableResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsLockableResourceCreate,
+		ReadContext:   resourceJenkinsLockableResourceRead,
+		UpdateContext: resourceJenkinsLockableResourceUpdate,
+		DeleteContext: resourceJenkinsLockableResourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the lockable resource, as referenced by the lock step.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "A description of the lockable resource.",
+				Optional:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "Labels allowing the resource to be locked by label rather than name.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"reserved_by": {
+				Type:        schema.TypeString,
+				Description: "The user the resource is reserved by, preventing it from being locked.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsLockableResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Get("name").(string)
+
+	input := scriptInput(d, "name", "description", "labels", "reserved_by")
+	if err := executeScript(ctx, client, lockableResourceUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error creating lockable resource %q: %w", name, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - lockable resource %q created", name)
+	d.SetId(name)
+
+	return resourceJenkinsLockableResourceRead(ctx, d, meta)
+}
+
+func resourceJenkinsLockableResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, lockableResourceReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading lockable resource %q: %w", d.Id(), err))
+	}
+
+	if len(output) == 0 {
+		// Lockable resource does not exist
+		d.SetId("")
+		return nil
+	}
+
+	output["name"] = d.Id()
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsLockableResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "name", "description", "labels", "reserved_by")
+	if err := executeScript(ctx, client, lockableResourceUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error updating lockable resource %q: %w", d.Id(), err))
+	}
+
+	return resourceJenkinsLockableResourceRead(ctx, d, meta)
+}
+
+func resourceJenkinsLockableResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, lockableResourceDeleteScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error deleting lockable resource %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsLockableResource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_lockable_resource foo {
+				  name        = "tf-acc-test-%s"
+				  description = "Terraform acceptance tests"
+				  labels      = ["staging", "database"]
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_lockable_resource.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_lockable_resource.foo", "description", "Terraform acceptance tests"),
+					resource.TestCheckResourceAttr("jenkins_lockable_resource.foo", "labels.#", "2"),
+				),
+			},
+			{
+				// Update by reserving the resource
+				Config: fmt.Sprintf(`
+				resource jenkins_lockable_resource foo {
+				  name        = "tf-acc-test-%s"
+				  description = "Terraform acceptance tests"
+				  labels      = ["staging"]
+				  reserved_by = "admin"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_lockable_resource.foo", "labels.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_lockable_resource.foo", "reserved_by", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsLockableResourceRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       diag.Diagnostics
+		wantID     string
+		wantLabels int
+	}{
+		{
+			name: "exists",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"description":"","labels":["staging","database"],"reserved_by":""}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsLockableResource().Schema, map[string]interface{}{}),
+			},
+			wantID:     "lock",
+			wantLabels: 2,
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsLockableResource().Schema, map[string]interface{}{}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("lock")
+			if got := resourceJenkinsLockableResourceRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsLockableResourceRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsLockableResourceRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+			if got := tt.args.d.Get("labels").(*schema.Set).Len(); got != tt.wantLabels {
+				t.Errorf("resourceJenkinsLockableResourceRead() labels = %v, want %v", got, tt.wantLabels)
+			}
+		})
+	}
+}
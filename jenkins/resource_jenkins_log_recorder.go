@@ -0,0 +1,137 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const logRecorderReadScript = `
+	def recorder = jenkins.model.Jenkins.get().log.getLogRecorder(input.name)
+	if (recorder == null) {
+		return null
+	}
+	return [
+		logger: recorder.loggers.collect { [name: it.name, level: it.level.name] },
+	]
+`
+
+const logRecorderUpdateScript = `
+	def manager = jenkins.model.Jenkins.get().log
+	def recorder = manager.getLogRecorder(input.name)
+	if (recorder == null) {
+		recorder = new hudson.logging.LogRecorder(input.name)
+		manager.recorders = manager.recorders + recorder
+	}
+	recorder.loggers = input.logger.collect {
+		new hudson.logging.LogRecorder.Target(it.name, java.util.logging.Level.parse(it.level))
+	}
+	recorder.loggers.each { it.enable() }
+	recorder.save()
+`
+
+const logRecorderDeleteScript = `
+	def recorder = jenkins.model.Jenkins.get().log.getLogRecorder(input.name)
+	if (recorder != null) {
+		recorder.delete()
+	}
+`
+
+func resourceJenkinsLogRecorder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsLogRecorderCreate,
+		ReadContext:   resourceJenkinsLogRecorderRead,
+		UpdateContext: resourceJenkinsLogRecorderUpdate,
+		DeleteContext: resourceJenkinsLogRecorderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the log recorder.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"logger": {
+				Type:        schema.TypeSet,
+				Description: "The loggers whose records are collected.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the logger, usually a Java package or class name.",
+							Required:    true,
+						},
+						"level": {
+							Type:             schema.TypeString,
+							Description:      "The minimum level of records to collect.",
+							Optional:         true,
+							Default:          "ALL",
+							ValidateDiagFunc: validateLogLevel,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceJenkinsLogRecorderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Get("name").(string)
+
+	if err := executeScript(ctx, client, logRecorderUpdateScript, scriptInput(d, "name", "logger"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error creating log recorder %q: %w", name, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - log recorder %q created", name)
+	d.SetId(name)
+
+	return resourceJenkinsLogRecorderRead(ctx, d, meta)
+}
+
+func resourceJenkinsLogRecorderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, logRecorderReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading log recorder %q: %w", d.Id(), err))
+	}
+
+	if len(output) == 0 {
+		// Log recorder does not exist
+		d.SetId("")
+		return nil
+	}
+
+	output["name"] = d.Id()
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsLogRecorderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, logRecorderUpdateScript, scriptInput(d, "name", "logger"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error updating log recorder %q: %w", d.Id(), err))
+	}
+
+	return resourceJenkinsLogRecorderRead(ctx, d, meta)
+}
+
+func resourceJenkinsLogRecorderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, logRecorderDeleteScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error deleting log recorder %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsLogRecorder_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_log_recorder foo {
+				  name = "tf-acc-test-%s"
+
+				  logger {
+				    name  = "hudson.plugins.git"
+				    level = "FINE"
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_log_recorder.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_log_recorder.foo", "logger.#", "1"),
+				),
+			},
+			{
+				// Update by adding another logger
+				Config: fmt.Sprintf(`
+				resource jenkins_log_recorder foo {
+				  name = "tf-acc-test-%s"
+
+				  logger {
+				    name  = "hudson.plugins.git"
+				    level = "FINE"
+				  }
+
+				  logger {
+				    name = "jenkins.plugins.git"
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_log_recorder.foo", "logger.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsLogRecorderCreate(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   diag.Diagnostics
+		wantID string
+	}{
+		{
+			name: "success",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						if strings.Contains(script, "new hudson.logging.LogRecorder(") && !strings.Contains(script, `"level":"FINE"`) {
+							t.Errorf("Expected the update script to receive the logger levels: %s", script)
+						}
+						return `{"result":{"logger":[{"name":"hudson.plugins.git","level":"FINE"}]}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsLogRecorder().Schema, map[string]interface{}{
+					"name": "git",
+					"logger": []interface{}{
+						map[string]interface{}{"name": "hudson.plugins.git", "level": "FINE"},
+					},
+				}),
+			},
+			wantID: "git",
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"error":"java.lang.IllegalArgumentException: Bad level \"LOUD\""}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsLogRecorder().Schema, map[string]interface{}{
+					"name": "git",
+				}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::create - Error creating log recorder \"git\": script failed: java.lang.IllegalArgumentException: Bad level \"LOUD\""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceJenkinsLogRecorderCreate(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsLogRecorderCreate() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsLogRecorderCreate() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const mailerReadScript = `
+	def mailer = jenkins.model.Jenkins.get().getDescriptorByType(hudson.tasks.Mailer.DescriptorImpl)
+	return [
+		smtp_host:        mailer.smtpHost ?: '',
+		smtp_port:        mailer.smtpPort ? mailer.smtpPort as Integer : 0,
+		smtp_username:    mailer.authentication?.username ?: '',
+		use_ssl:          mailer.useSsl,
+		use_tls:          mailer.useTls,
+		reply_to_address: mailer.replyToAddress ?: '',
+		default_suffix:   mailer.defaultSuffix ?: '',
+		charset:          mailer.charset,
+	]
+`
+
+const mailerUpdateScript = `
+	def mailer = jenkins.model.Jenkins.get().getDescriptorByType(hudson.tasks.Mailer.DescriptorImpl)
+	mailer.smtpHost = input.smtp_host ?: null
+	mailer.smtpPort = input.smtp_port ? input.smtp_port.toString() : null
+	mailer.authentication = input.smtp_username ? new hudson.tasks.SMTPAuthentication(input.smtp_username, hudson.util.Secret.fromString(input.smtp_password)) : null
+	mailer.useSsl = input.use_ssl
+	mailer.useTls = input.use_tls
+	mailer.replyToAddress = input.reply_to_address ?: null
+	mailer.defaultSuffix = input.default_suffix ?: null
+	mailer.charset = input.charset
+	mailer.save()
+`
+
+var mailerProperties = []string{"smtp_host", "smtp_port", "smtp_username", "smtp_password", "use_ssl", "use_tls", "reply_to_address", "default_suffix", "charset"}
+
+func resourceJenkinsMailer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsMailerCreate,
+		ReadContext:   resourceJenkinsMailerRead,
+		UpdateContext: resourceJenkinsMailerUpdate,
+		DeleteContext: resourceJenkinsMailerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"smtp_host": {
+				Type:        schema.TypeString,
+				Description: "The hostname of the SMTP server.",
+				Required:    true,
+			},
+			"smtp_port": {
+				Type:        schema.TypeInt,
+				Description: "The port of the SMTP server. Leave unset to use the protocol default.",
+				Optional:    true,
+			},
+			"smtp_username": {
+				Type:        schema.TypeString,
+				Description: "The username to authenticate to the SMTP server with.",
+				Optional:    true,
+			},
+			"smtp_password": {
+				Type:        schema.TypeString,
+				Description: "The password to authenticate to the SMTP server with.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"use_ssl": {
+				Type:        schema.TypeBool,
+				Description: "Whether to connect to the SMTP server over SSL.",
+				Optional:    true,
+				Default:     false,
+			},
+			"use_tls": {
+				Type:        schema.TypeBool,
+				Description: "Whether to upgrade the SMTP connection with STARTTLS.",
+				Optional:    true,
+				Default:     false,
+			},
+			"reply_to_address": {
+				Type:        schema.TypeString,
+				Description: "The Reply-To address of notification emails.",
+				Optional:    true,
+			},
+			"default_suffix": {
+				Type:        schema.TypeString,
+				Description: "The domain suffix appended to user names to form email addresses, such as \"@example.com\".",
+				Optional:    true,
+			},
+			"charset": {
+				Type:        schema.TypeString,
+				Description: "The character set of notification emails.",
+				Optional:    true,
+				Default:     "UTF-8",
+			},
+		},
+	}
+}
+
+func resourceJenkinsMailerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, mailerUpdateScript, scriptInput(d, mailerProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring mailer: %w", err))
+	}
+
+	d.SetId("mailer")
+	return resourceJenkinsMailerRead(ctx, d, meta)
+}
+
+func resourceJenkinsMailerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// The SMTP password is not read back, so changes to it made outside of Terraform are not detected
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, mailerReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading mailer: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsMailerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, mailerUpdateScript, scriptInput(d, mailerProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring mailer: %w", err))
+	}
+
+	return resourceJenkinsMailerRead(ctx, d, meta)
+}
+
+func resourceJenkinsMailerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Clear the configuration back to the plugin's defaults
+	input := map[string]interface{}{"use_ssl": false, "use_tls": false, "charset": "UTF-8"}
+	if err := executeScript(ctx, client, mailerUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error clearing mailer: %w", err))
+	}
+
+	return nil
+}
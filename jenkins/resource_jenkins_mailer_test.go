@@ -0,0 +1,96 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsMailer_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_mailer foo {
+				  smtp_host        = "smtp.example.com"
+				  smtp_port        = 587
+				  smtp_username    = "jenkins"
+				  smtp_password    = "hunter2"
+				  use_tls          = true
+				  reply_to_address = "noreply@example.com"
+				  default_suffix   = "@example.com"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_mailer.foo", "id", "mailer"),
+					resource.TestCheckResourceAttr("jenkins_mailer.foo", "smtp_host", "smtp.example.com"),
+					resource.TestCheckResourceAttr("jenkins_mailer.foo", "smtp_port", "587"),
+					resource.TestCheckResourceAttr("jenkins_mailer.foo", "smtp_username", "jenkins"),
+					resource.TestCheckResourceAttr("jenkins_mailer.foo", "use_tls", "true"),
+					resource.TestCheckResourceAttr("jenkins_mailer.foo", "charset", "UTF-8"),
+				),
+			},
+			{
+				// Update by removing authentication
+				Config: `
+				resource jenkins_mailer foo {
+				  smtp_host = "smtp.example.com"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_mailer.foo", "smtp_port", "0"),
+					resource.TestCheckResourceAttr("jenkins_mailer.foo", "smtp_username", ""),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsMailerRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":{"smtp_host":"smtp.example.com","smtp_port":25,"smtp_username":"jenkins","use_ssl":false,"use_tls":true,"reply_to_address":"","default_suffix":"@example.com","charset":"UTF-8"}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsMailer().Schema, map[string]interface{}{
+		"smtp_host":     "smtp.example.com",
+		"smtp_password": "hunter2",
+	})
+	d.SetId("mailer")
+
+	if got := resourceJenkinsMailerRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsMailerRead() = %v, want nil", got)
+	}
+	if d.Get("smtp_port").(int) != 25 {
+		t.Errorf("Expected smtp_port 25 but received %v", d.Get("smtp_port"))
+	}
+	if d.Get("smtp_password").(string) != "hunter2" {
+		t.Errorf("Expected the configured password to be preserved")
+	}
+}
+
+func Test_resourceJenkinsMailerDelete(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if strings.Contains(script, "smtp.example.com") {
+				t.Errorf("Expected the SMTP host to be cleared: %s", script)
+			}
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsMailer().Schema, map[string]interface{}{
+		"smtp_host": "smtp.example.com",
+	})
+	d.SetId("mailer")
+
+	if got := resourceJenkinsMailerDelete(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsMailerDelete() = %v, want nil", got)
+	}
+}
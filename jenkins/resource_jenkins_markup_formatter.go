@@ -0,0 +1,106 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Formatters other than the two supported ones are reported by their class name, so that they show as drift.
+const markupFormatterReadScript = `
+	def formatter = jenkins.model.Jenkins.get().markupFormatter
+	switch (formatter.class.name) {
+		case 'hudson.markup.EscapedMarkupFormatter':
+			return [formatter: 'plain_text', disable_syntax_highlighting: false]
+		case 'hudson.markup.RawHtmlMarkupFormatter':
+			return [formatter: 'safe_html', disable_syntax_highlighting: formatter.disableSyntaxHighlighting]
+		default:
+			return [formatter: formatter.class.name, disable_syntax_highlighting: false]
+	}
+`
+
+const markupFormatterUpdateScript = `
+	def instance = jenkins.model.Jenkins.get()
+	if (input.formatter == 'safe_html') {
+		def type = instance.pluginManager.uberClassLoader.loadClass('hudson.markup.RawHtmlMarkupFormatter')
+		instance.markupFormatter = type.newInstance(input.disable_syntax_highlighting)
+	} else {
+		instance.markupFormatter = new hudson.markup.EscapedMarkupFormatter()
+	}
+	instance.save()
+`
+
+func resourceJenkinsMarkupFormatter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsMarkupFormatterCreate,
+		ReadContext:   resourceJenkinsMarkupFormatterRead,
+		UpdateContext: resourceJenkinsMarkupFormatterUpdate,
+		DeleteContext: resourceJenkinsMarkupFormatterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"formatter": {
+				Type:             schema.TypeString,
+				Description:      "How descriptions are rendered, either plain_text or safe_html.",
+				Required:         true,
+				ValidateDiagFunc: validateMarkupFormatter,
+			},
+			"disable_syntax_highlighting": {
+				Type:        schema.TypeBool,
+				Description: "Whether to disable syntax highlighting of code blocks when using the safe_html formatter.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsMarkupFormatterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "formatter", "disable_syntax_highlighting")
+	if err := executeScript(ctx, client, markupFormatterUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring markup formatter: %w", err))
+	}
+
+	d.SetId("markup-formatter")
+	return resourceJenkinsMarkupFormatterRead(ctx, d, meta)
+}
+
+func resourceJenkinsMarkupFormatterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, markupFormatterReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading markup formatter: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsMarkupFormatterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "formatter", "disable_syntax_highlighting")
+	if err := executeScript(ctx, client, markupFormatterUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring markup formatter: %w", err))
+	}
+
+	return resourceJenkinsMarkupFormatterRead(ctx, d, meta)
+}
+
+func resourceJenkinsMarkupFormatterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Restore the Jenkins default of rendering descriptions as plain text
+	input := map[string]interface{}{"formatter": "plain_text", "disable_syntax_highlighting": false}
+	if err := executeScript(ctx, client, markupFormatterUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring markup formatter: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsMarkupFormatter_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_markup_formatter foo {
+				  formatter = "plain_text"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_markup_formatter.foo", "id", "markup-formatter"),
+					resource.TestCheckResourceAttr("jenkins_markup_formatter.foo", "formatter", "plain_text"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsMarkupFormatterRead(t *testing.T) {
+	tests := []struct {
+		name          string
+		output        string
+		wantFormatter string
+	}{
+		{
+			name:          "safe html",
+			output:        `{"result":{"formatter":"safe_html","disable_syntax_highlighting":true}}`,
+			wantFormatter: "safe_html",
+		},
+		{
+			name:          "other formatter",
+			output:        `{"result":{"formatter":"io.jenkins.plugins.markdown.MarkdownFormatter","disable_syntax_highlighting":false}}`,
+			wantFormatter: "io.jenkins.plugins.markdown.MarkdownFormatter",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := &mockJenkinsClient{
+				mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+					return tt.output, nil
+				},
+			}
+
+			d := schema.TestResourceDataRaw(t, resourceJenkinsMarkupFormatter().Schema, map[string]interface{}{})
+			d.SetId("markup-formatter")
+			if got := resourceJenkinsMarkupFormatterRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+				t.Errorf("resourceJenkinsMarkupFormatterRead() = %v, want nil", got)
+			}
+			if got := d.Get("formatter").(string); got != tt.wantFormatter {
+				t.Errorf("resourceJenkinsMarkupFormatterRead() formatter = %q, want %q", got, tt.wantFormatter)
+			}
+		})
+	}
+}
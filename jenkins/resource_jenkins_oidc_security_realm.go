@@ -0,0 +1,249 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const oidcSecurityRealmReadScript = `
+	def realm = jenkins.model.Jenkins.get().securityRealm
+	if (!(realm instanceof org.jenkinsci.plugins.oic.OicSecurityRealm)) {
+		return null
+	}
+	def result = [
+		client_id:                realm.clientId,
+		user_name_field:          realm.userNameField ?: '',
+		full_name_field:          realm.fullNameFieldName ?: '',
+		email_field:              realm.emailFieldName ?: '',
+		groups_field:             realm.groupsFieldName ?: '',
+		logout_from_provider:     realm.logoutFromOpenidProvider,
+		post_logout_redirect_url: realm.postLogoutRedirectUrl ?: '',
+		escape_hatch_enabled:     realm.escapeHatchEnabled,
+		escape_hatch_username:    realm.escapeHatchUsername ?: '',
+		escape_hatch_group:       realm.escapeHatchGroup ?: '',
+		disable_ssl_verification: realm.disableSslVerification,
+		pkce_enabled:             realm.pkceEnabled,
+	]
+	def server = realm.serverConfiguration
+	if (server instanceof org.jenkinsci.plugins.oic.OicServerWellKnownConfiguration) {
+		result.well_known_url = server.wellKnownOpenIDConfigurationUrl
+		result.scopes = server.scopesOverride ?: ''
+	} else {
+		result.issuer = server.issuer
+		result.authorization_server_url = server.authorizationServerUrl
+		result.token_server_url = server.tokenServerUrl
+		result.scopes = server.scopes ?: ''
+	}
+	return result
+`
+
+// As with the SAML realm, the realm is built through its data-bound constructor and setters,
+// because its constructor arguments have changed between releases of the plugin.
+const oidcSecurityRealmUpdateScript = `
+	def model = { type, args -> org.jenkinsci.plugins.structs.describable.DescribableModel.of(type).instantiate(args) }
+	def server = input.well_known_url ?
+		model(org.jenkinsci.plugins.oic.OicServerWellKnownConfiguration, [wellKnownOpenIDConfigurationUrl: input.well_known_url, scopesOverride: input.scopes ?: null]) :
+		model(org.jenkinsci.plugins.oic.OicServerManualConfiguration, [issuer: input.issuer, authorizationServerUrl: input.authorization_server_url, tokenServerUrl: input.token_server_url, scopes: input.scopes ?: 'openid email'])
+
+	def realm = model(org.jenkinsci.plugins.oic.OicSecurityRealm, [
+		clientId:                 input.client_id,
+		clientSecret:             hudson.util.Secret.fromString(input.client_secret),
+		serverConfiguration:      server,
+		userNameField:            input.user_name_field ?: null,
+		fullNameFieldName:        input.full_name_field ?: null,
+		emailFieldName:           input.email_field ?: null,
+		groupsFieldName:          input.groups_field ?: null,
+		logoutFromOpenidProvider: input.logout_from_provider,
+		postLogoutRedirectUrl:    input.post_logout_redirect_url ?: null,
+		escapeHatchEnabled:       input.escape_hatch_enabled,
+		escapeHatchUsername:      input.escape_hatch_username ?: null,
+		escapeHatchSecret:        hudson.util.Secret.fromString(input.escape_hatch_secret),
+		escapeHatchGroup:         input.escape_hatch_group ?: null,
+		disableSslVerification:   input.disable_ssl_verification,
+		pkceEnabled:              input.pkce_enabled,
+	])
+
+	def instance = jenkins.model.Jenkins.get()
+	instance.securityRealm = realm
+	instance.save()
+`
+
+var oidcSecurityRealmProperties = []string{
+	"well_known_url", "issuer", "authorization_server_url", "token_server_url", "scopes", "client_id", "client_secret",
+	"user_name_field", "full_name_field", "email_field", "groups_field", "logout_from_provider", "post_logout_redirect_url",
+	"escape_hatch_enabled", "escape_hatch_username", "escape_hatch_secret", "escape_hatch_group", "disable_ssl_verification",
+	"pkce_enabled",
+}
+
+func resourceJenkinsOIDCSecurityRealm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsOIDCSecurityRealmCreate,
+		ReadContext:   resourceJenkinsOIDCSecurityRealmRead,
+		UpdateContext: resourceJenkinsOIDCSecurityRealmUpdate,
+		DeleteContext: resourceJenkinsOIDCSecurityRealmDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"well_known_url": {
+				Type:         schema.TypeString,
+				Description:  "The URL of the OpenID Connect discovery document of the provider.",
+				Optional:     true,
+				ExactlyOneOf: []string{"well_known_url", "issuer"},
+			},
+			"issuer": {
+				Type:         schema.TypeString,
+				Description:  "The issuer of the provider, when configuring its endpoints manually.",
+				Optional:     true,
+				ExactlyOneOf: []string{"well_known_url", "issuer"},
+				RequiredWith: []string{"authorization_server_url", "token_server_url"},
+			},
+			"authorization_server_url": {
+				Type:        schema.TypeString,
+				Description: "The authorization endpoint of the provider, when configuring its endpoints manually.",
+				Optional:    true,
+			},
+			"token_server_url": {
+				Type:        schema.TypeString,
+				Description: "The token endpoint of the provider, when configuring its endpoints manually.",
+				Optional:    true,
+			},
+			"scopes": {
+				Type:        schema.TypeString,
+				Description: "The space-separated scopes to request.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the client registered with the provider.",
+				Required:    true,
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "The secret of the client registered with the provider.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"user_name_field": {
+				Type:        schema.TypeString,
+				Description: "The claim holding the username.",
+				Optional:    true,
+				Default:     "sub",
+			},
+			"full_name_field": {
+				Type:        schema.TypeString,
+				Description: "The claim holding the full name of the user.",
+				Optional:    true,
+			},
+			"email_field": {
+				Type:        schema.TypeString,
+				Description: "The claim holding the email address of the user.",
+				Optional:    true,
+			},
+			"groups_field": {
+				Type:        schema.TypeString,
+				Description: "The claim holding the groups of the user.",
+				Optional:    true,
+			},
+			"logout_from_provider": {
+				Type:        schema.TypeBool,
+				Description: "Whether logging out of Jenkins also logs the user out of the provider.",
+				Optional:    true,
+				Default:     false,
+			},
+			"post_logout_redirect_url": {
+				Type:        schema.TypeString,
+				Description: "The URL the provider sends users to after logging out.",
+				Optional:    true,
+			},
+			"escape_hatch_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether a local user may still log in while the provider is unavailable.",
+				Optional:    true,
+				Default:     false,
+			},
+			"escape_hatch_username": {
+				Type:        schema.TypeString,
+				Description: "The username of the escape hatch user.",
+				Optional:    true,
+			},
+			"escape_hatch_secret": {
+				Type:        schema.TypeString,
+				Description: "The password of the escape hatch user.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"escape_hatch_group": {
+				Type:        schema.TypeString,
+				Description: "The group the escape hatch user is a member of.",
+				Optional:    true,
+			},
+			"disable_ssl_verification": {
+				Type:        schema.TypeBool,
+				Description: "Whether to skip verifying the certificates of the provider.",
+				Optional:    true,
+				Default:     false,
+			},
+			"pkce_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether to use Proof Key for Code Exchange during authentication.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsOIDCSecurityRealmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, oidcSecurityRealmProperties...)
+	if err := executeScript(ctx, client, oidcSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring OpenID Connect security realm: %w", err))
+	}
+
+	d.SetId("oidc-security-realm")
+	return resourceJenkinsOIDCSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsOIDCSecurityRealmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, oidcSecurityRealmReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading OpenID Connect security realm: %w", err))
+	}
+
+	if len(output) == 0 {
+		// Jenkins has been switched to another security realm
+		log.Printf("[DEBUG] jenkins::read - Jenkins is no longer using the OpenID Connect security realm")
+		d.SetId("")
+		return nil
+	}
+
+	// The client and escape hatch secrets cannot be read back, so the configured values are kept
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsOIDCSecurityRealmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, oidcSecurityRealmProperties...)
+	if err := executeScript(ctx, client, oidcSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring OpenID Connect security realm: %w", err))
+	}
+
+	return resourceJenkinsOIDCSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsOIDCSecurityRealmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Falling back to another security realm would lock out every OpenID Connect user, so the realm is left in place.
+	log.Printf("[DEBUG] jenkins::delete - Removing OpenID Connect security realm %q from state", d.Id())
+	return nil
+}
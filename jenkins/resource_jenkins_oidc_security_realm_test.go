@@ -0,0 +1,69 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// There is no acceptance test, as switching the test instance over to OpenID Connect would lock out the remaining tests.
+
+func Test_resourceJenkinsOIDCSecurityRealmCreate(t *testing.T) {
+	var scripts []string
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			scripts = append(scripts, script)
+			return `{"result":{"client_id":"jenkins","user_name_field":"preferred_username","full_name_field":"name","email_field":"email","groups_field":"groups","logout_from_provider":true,"post_logout_redirect_url":"","escape_hatch_enabled":true,"escape_hatch_username":"break-glass","escape_hatch_group":"","disable_ssl_verification":false,"pkce_enabled":true,"well_known_url":"https://keycloak.example.com/realms/ci/.well-known/openid-configuration","scopes":""}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsOIDCSecurityRealm().Schema, map[string]interface{}{
+		"well_known_url":        "https://keycloak.example.com/realms/ci/.well-known/openid-configuration",
+		"client_id":             "jenkins",
+		"client_secret":         "hunter2",
+		"user_name_field":       "preferred_username",
+		"full_name_field":       "name",
+		"email_field":           "email",
+		"groups_field":          "groups",
+		"logout_from_provider":  true,
+		"escape_hatch_enabled":  true,
+		"escape_hatch_username": "break-glass",
+		"escape_hatch_secret":   "correct-horse",
+		"pkce_enabled":          true,
+	})
+
+	if got := resourceJenkinsOIDCSecurityRealmCreate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsOIDCSecurityRealmCreate() = %v, want nil", got)
+	}
+	if d.Id() != "oidc-security-realm" {
+		t.Errorf("Expected ID %q but received %q", "oidc-security-realm", d.Id())
+	}
+	if len(scripts) != 2 || !strings.Contains(scripts[0], `"client_secret":"hunter2"`) {
+		t.Errorf("Expected the update script to receive the client secret: %v", scripts)
+	}
+	if d.Get("client_secret").(string) != "hunter2" || d.Get("escape_hatch_secret").(string) != "correct-horse" {
+		t.Errorf("Expected the configured secrets to be preserved")
+	}
+}
+
+func Test_resourceJenkinsOIDCSecurityRealmRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsOIDCSecurityRealm().Schema, map[string]interface{}{})
+	d.SetId("oidc-security-realm")
+
+	if got := resourceJenkinsOIDCSecurityRealmRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsOIDCSecurityRealmRead() = %v, want nil", got)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected the resource to be removed once another realm is in use")
+	}
+}
@@ -0,0 +1,84 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const pipelineDurabilityReadScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(org.jenkinsci.plugins.workflow.flow.GlobalDefaultFlowDurabilityLevel.DescriptorImpl)
+	return [durability_hint: config.durabilityHint?.name() ?: 'MAX_SURVIVABILITY']
+`
+
+const pipelineDurabilityUpdateScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(org.jenkinsci.plugins.workflow.flow.GlobalDefaultFlowDurabilityLevel.DescriptorImpl)
+	config.durabilityHint = input.durability_hint ? org.jenkinsci.plugins.workflow.flow.FlowDurabilityHint.valueOf(input.durability_hint) : null
+	config.save()
+`
+
+func resourceJenkinsPipelineDurability() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsPipelineDurabilityCreate,
+		ReadContext:   resourceJenkinsPipelineDurabilityRead,
+		UpdateContext: resourceJenkinsPipelineDurabilityUpdate,
+		DeleteContext: resourceJenkinsPipelineDurabilityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"durability_hint": {
+				Type:             schema.TypeString,
+				Description:      "The default durability of pipelines: PERFORMANCE_OPTIMIZED, SURVIVABLE_NONATOMIC or MAX_SURVIVABILITY.",
+				Required:         true,
+				ValidateDiagFunc: validateDurabilityHint,
+			},
+		},
+	}
+}
+
+func resourceJenkinsPipelineDurabilityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, pipelineDurabilityUpdateScript, scriptInput(d, "durability_hint"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring pipeline durability: %w", err))
+	}
+
+	d.SetId("pipeline-durability")
+	return resourceJenkinsPipelineDurabilityRead(ctx, d, meta)
+}
+
+func resourceJenkinsPipelineDurabilityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, pipelineDurabilityReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading pipeline durability: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsPipelineDurabilityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, pipelineDurabilityUpdateScript, scriptInput(d, "durability_hint"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring pipeline durability: %w", err))
+	}
+
+	return resourceJenkinsPipelineDurabilityRead(ctx, d, meta)
+}
+
+func resourceJenkinsPipelineDurabilityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// An empty input restores the Jenkins default
+	if err := executeScript(ctx, client, pipelineDurabilityUpdateScript, map[string]interface{}{}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring pipeline durability: %w", err))
+	}
+
+	return nil
+}
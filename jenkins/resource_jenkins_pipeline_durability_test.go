@@ -0,0 +1,82 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsPipelineDurability_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_pipeline_durability foo {
+				  durability_hint = "PERFORMANCE_OPTIMIZED"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_pipeline_durability.foo", "id", "pipeline-durability"),
+					resource.TestCheckResourceAttr("jenkins_pipeline_durability.foo", "durability_hint", "PERFORMANCE_OPTIMIZED"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsPipelineDurabilityRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     diag.Diagnostics
+		wantHint string
+	}{
+		{
+			name: "success",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"durability_hint":"PERFORMANCE_OPTIMIZED"}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsPipelineDurability().Schema, map[string]interface{}{}),
+			},
+			wantHint: "PERFORMANCE_OPTIMIZED",
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return "", fmt.Errorf("403 Forbidden")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsPipelineDurability().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::read - Error reading pipeline durability: 403 Forbidden"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceJenkinsPipelineDurabilityRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsPipelineDurabilityRead() = %v, want %v", got, tt.want)
+			}
+			if got := tt.args.d.Get("durability_hint").(string); got != tt.wantHint {
+				t.Errorf("resourceJenkinsPipelineDurabilityRead() durability_hint = %v, want %v", got, tt.wantHint)
+			}
+		})
+	}
+}
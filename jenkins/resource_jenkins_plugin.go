@@ -0,0 +1,210 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultPluginURL is the location that pinned plugin versions are downloaded from when no URL is given.
+const defaultPluginURL = "https://updates.jenkins.io/download/plugins/%[1]s/%[2]s/%[1]s.hpi"
+
+func resourceJenkinsPlugin() *schema.Resource {
+	// Installing and uninstalling plugins may wait for downloads and a restart of Jenkins
+	timeouts := defaultResourceTimeouts()
+	timeouts.Create = schema.DefaultTimeout(10 * time.Minute)
+	timeouts.Update = schema.DefaultTimeout(10 * time.Minute)
+	timeouts.Delete = schema.DefaultTimeout(10 * time.Minute)
+
+	return &schema.Resource{
+		CreateContext: resourceJenkinsPluginCreate,
+		ReadContext:   resourceJenkinsPluginRead,
+		UpdateContext: resourceJenkinsPluginUpdate,
+		DeleteContext: resourceJenkinsPluginDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: timeouts,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The short name of the plugin, such as \"git\".",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "The exact version of the plugin to install.",
+				Required:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "A direct URL to the plugin's .hpi file. Defaults to the version's download from the Jenkins update site.",
+				Optional:    true,
+			},
+			"safe_restart": {
+				Type:        schema.TypeBool,
+				Description: "Whether to safely restart Jenkins when the change requires a restart to take effect.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsPluginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Get("name").(string)
+
+	if err := installPlugin(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error installing plugin %q: %w", name, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - plugin %q installed", name)
+	d.SetId(name)
+
+	return resourceJenkinsPluginRead(ctx, d, meta)
+}
+
+func resourceJenkinsPluginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Id()
+
+	plugins, err := client.GetPlugins(ctx, 1)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not list plugins: %w", err))
+	}
+
+	plugin := plugins.Contains(name)
+	if plugin == nil {
+		// Plugin is not installed
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("name", plugin.ShortName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Upgrades still waiting on a restart continue to report the previous version
+	if plugin.Version != d.Get("version").(string) {
+		job, err := latestPluginInstallation(ctx, client, name)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::read - Could not list plugin installations: %w", err))
+		}
+
+		if job == nil || job.Status.Type != "SuccessButRequiresRestart" {
+			if err := d.Set("version", plugin.Version); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceJenkinsPluginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Id()
+
+	if d.HasChange("version") || d.HasChange("url") {
+		if err := installPlugin(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::update - Error installing plugin %q: %w", name, err))
+		}
+	}
+
+	return resourceJenkinsPluginRead(ctx, d, meta)
+}
+
+func resourceJenkinsPluginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Id()
+
+	log.Printf("[DEBUG] jenkins::delete - Uninstalling plugin %q", name)
+	if err := client.UninstallPlugin(ctx, name); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error uninstalling plugin %q: %w", name, err))
+	}
+
+	// Uninstallation only ever takes effect after a restart
+	if d.Get("safe_restart").(bool) {
+		if err := restartJenkins(ctx, client, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::delete - Error restarting Jenkins: %w", err))
+		}
+	}
+
+	return nil
+}
+
+// installPlugin deploys the configured plugin version and waits for its installation to complete,
+// restarting Jenkins afterwards if that is needed and has been allowed.
+func installPlugin(ctx context.Context, client jenkinsClient, d *schema.ResourceData, timeout time.Duration) error {
+	name, version := d.Get("name").(string), d.Get("version").(string)
+	pluginURL := d.Get("url").(string)
+	if pluginURL == "" {
+		pluginURL = fmt.Sprintf(defaultPluginURL, name, version)
+	}
+
+	// Remember any earlier installation so that it is not mistaken for this one
+	previous, err := latestPluginInstallation(ctx, client, name)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] jenkins::plugin - Deploying %q from %s", name, pluginURL)
+	if err := client.DeployPlugin(ctx, pluginURL); err != nil {
+		return err
+	}
+
+	var job *updateCenterJob
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		job, err = latestPluginInstallation(ctx, client, name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if job == nil || (previous != nil && job.ID == previous.ID) {
+			return resource.RetryableError(fmt.Errorf("installation has not started yet"))
+		}
+
+		switch job.Status.Type {
+		case "Success", "SuccessButRequiresRestart":
+			return nil
+		case "Failure":
+			return resource.NonRetryableError(fmt.Errorf("installation failed: %s", job.ErrorMessage))
+		default:
+			return resource.RetryableError(fmt.Errorf("installation is %s", strings.ToLower(job.Status.Type)))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if job.Status.Type == "SuccessButRequiresRestart" && d.Get("safe_restart").(bool) {
+		return restartJenkins(ctx, client, timeout)
+	}
+
+	return nil
+}
+
+// latestPluginInstallation finds the most recent update center installation of the given plugin, if any.
+func latestPluginInstallation(ctx context.Context, client jenkinsClient, name string) (*updateCenterJob, error) {
+	jobs, err := client.GetUpdateCenterJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret *updateCenterJob
+	for i := range jobs {
+		if jobs[i].Type == "InstallationJob" && jobs[i].Name == name {
+			ret = &jobs[i]
+		}
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,135 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsPlugin_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_plugin foo {
+				  name    = "ansicolor"
+				  version = "1.0.2"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_plugin.foo", "id", "ansicolor"),
+					resource.TestCheckResourceAttr("jenkins_plugin.foo", "version", "1.0.2"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsPluginRead(t *testing.T) {
+	plugins := installedPlugins{
+		{ShortName: "git", Version: "4.7.1"},
+	}
+
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        diag.Diagnostics
+		wantID      string
+		wantVersion string
+	}{
+		{
+			name: "installed",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockGetPlugins: func(ctx context.Context, depth int) (installedPlugins, error) {
+						return plugins, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsPlugin().Schema, map[string]interface{}{
+					"name":    "git",
+					"version": "4.7.1",
+				}),
+			},
+			wantID:      "git",
+			wantVersion: "4.7.1",
+		},
+		{
+			name: "pending-restart",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockGetPlugins: func(ctx context.Context, depth int) (installedPlugins, error) {
+						return plugins, nil
+					},
+					mockGetUpdateCenterJobs: func(ctx context.Context) ([]updateCenterJob, error) {
+						job := updateCenterJob{Type: "InstallationJob", Name: "git"}
+						job.Status.Type = "SuccessButRequiresRestart"
+						return []updateCenterJob{job}, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsPlugin().Schema, map[string]interface{}{
+					"name":    "git",
+					"version": "4.8.0",
+				}),
+			},
+			wantID:      "git",
+			wantVersion: "4.8.0",
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockGetPlugins: func(ctx context.Context, depth int) (installedPlugins, error) {
+						return installedPlugins{}, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsPlugin().Schema, map[string]interface{}{
+					"name": "git",
+				}),
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockGetPlugins: func(ctx context.Context, depth int) (installedPlugins, error) {
+						return nil, fmt.Errorf("500")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsPlugin().Schema, map[string]interface{}{
+					"name": "git",
+				}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::read - Could not list plugins: 500"},
+			},
+			wantID: "git",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("git")
+			if got := resourceJenkinsPluginRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsPluginRead() = %v, want %v", got, tt.want)
+			}
+
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsPluginRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+
+			if tt.wantVersion != "" && tt.args.d.Get("version").(string) != tt.wantVersion {
+				t.Errorf("resourceJenkinsPluginRead() version = %q, want %q", tt.args.d.Get("version"), tt.wantVersion)
+			}
+		})
+	}
+}
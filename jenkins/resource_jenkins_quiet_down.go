@@ -0,0 +1,93 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceJenkinsQuietDown() *schema.Resource {
+	// Quieting down waits for running builds, which can take much longer than any other operation
+	timeouts := defaultResourceTimeouts()
+	timeouts.Create = schema.DefaultTimeout(30 * time.Minute)
+	timeouts.Update = schema.DefaultTimeout(30 * time.Minute)
+
+	return &schema.Resource{
+		CreateContext: resourceJenkinsQuietDownCreate,
+		ReadContext:   resourceJenkinsQuietDownRead,
+		UpdateContext: resourceJenkinsQuietDownUpdate,
+		DeleteContext: resourceJenkinsQuietDownDelete,
+		Timeouts:      timeouts,
+		Schema: map[string]*schema.Schema{
+			"reason": {
+				Type:        schema.TypeString,
+				Description: "The message displayed to users while Jenkins is quieting down.",
+				Optional:    true,
+			},
+			"wait_for_builds": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait for running builds to complete before continuing.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsQuietDownCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	err := client.QuietDown(ctx, d.Get("reason").(string), d.Get("wait_for_builds").(bool), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error putting Jenkins into quiet-down mode: %w", err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - Jenkins is quieting down")
+	d.SetId("quiet-down")
+
+	return resourceJenkinsQuietDownRead(ctx, d, meta)
+}
+
+func resourceJenkinsQuietDownRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	info, err := client.ServerInfo(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Could not read Jenkins status: %w", err))
+	}
+
+	if !info.QuietingDown {
+		// Quiet-down mode was cancelled, such as through the UI or a restart
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceJenkinsQuietDownUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Quieting down again replaces the reason given to users
+	err := client.QuietDown(ctx, d.Get("reason").(string), d.Get("wait_for_builds").(bool), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error putting Jenkins into quiet-down mode: %w", err))
+	}
+
+	return resourceJenkinsQuietDownRead(ctx, d, meta)
+}
+
+func resourceJenkinsQuietDownDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	log.Printf("[DEBUG] jenkins::delete - Cancelling quiet-down mode")
+	if err := client.CancelQuietDown(ctx); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error cancelling quiet-down mode: %w", err))
+	}
+
+	return nil
+}
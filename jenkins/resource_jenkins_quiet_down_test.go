@@ -0,0 +1,111 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJenkinsQuietDown_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJenkinsQuietDownDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_quiet_down foo {
+				  reason = "Terraform acceptance testing"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_quiet_down.foo", "id", "quiet-down"),
+					resource.TestCheckResourceAttr("jenkins_quiet_down.foo", "reason", "Terraform acceptance testing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJenkinsQuietDownDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(jenkinsClient)
+
+	info, err := client.ServerInfo(context.Background())
+	if err != nil {
+		return err
+	} else if info.QuietingDown {
+		return fmt.Errorf("Jenkins is still quieting down")
+	}
+
+	return nil
+}
+
+func Test_resourceJenkinsQuietDownRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   diag.Diagnostics
+		wantID string
+	}{
+		{
+			name: "quieting-down",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+						return &serverInfo{QuietingDown: true}, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsQuietDown().Schema, map[string]interface{}{}),
+			},
+			wantID: "quiet-down",
+		},
+		{
+			name: "cancelled",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+						return &serverInfo{QuietingDown: false}, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsQuietDown().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+						return nil, fmt.Errorf("503 Service Unavailable")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsQuietDown().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::read - Could not read Jenkins status: 503 Service Unavailable"},
+			},
+			wantID: "quiet-down",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("quiet-down")
+			if got := resourceJenkinsQuietDownRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsQuietDownRead() = %v, want %v", got, tt.want)
+			}
+
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsQuietDownRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceJenkinsSafeRestart() *schema.Resource {
+	// Restarting waits for running builds and for Jenkins to come back up
+	timeouts := defaultResourceTimeouts()
+	timeouts.Create = schema.DefaultTimeout(15 * time.Minute)
+
+	return &schema.Resource{
+		CreateContext: resourceJenkinsSafeRestartCreate,
+		ReadContext:   resourceJenkinsSafeRestartRead,
+		DeleteContext: resourceJenkinsSafeRestartDelete,
+		Timeouts:      timeouts,
+		Schema: map[string]*schema.Schema{
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that will cause Jenkins to be restarted again when changed.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceJenkinsSafeRestartCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	log.Printf("[DEBUG] jenkins::create - Safely restarting Jenkins")
+	if err := restartJenkins(ctx, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error restarting Jenkins: %w", err))
+	}
+
+	d.SetId(resource.UniqueId())
+	return resourceJenkinsSafeRestartRead(ctx, d, meta)
+}
+
+func resourceJenkinsSafeRestartRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A restart leaves nothing behind in Jenkins that could be refreshed
+	return nil
+}
+
+func resourceJenkinsSafeRestartDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,83 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsSafeRestart_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_safe_restart foo {
+				  triggers = {
+				    reason = "acceptance testing"
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jenkins_safe_restart.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsSafeRestartCreate(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name string
+		args args
+		want diag.Diagnostics
+	}{
+		{
+			name: "success",
+			args: args{
+				ctx: context.Background(),
+				meta: &mockJenkinsClient{
+					mockSafeRestart: func(ctx context.Context) error {
+						return nil
+					},
+					mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+						return &serverInfo{}, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsSafeRestart().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				ctx: context.Background(),
+				meta: &mockJenkinsClient{
+					mockSafeRestart: func(ctx context.Context) error {
+						return fmt.Errorf("403 Forbidden")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsSafeRestart().Schema, map[string]interface{}{}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::create - Error restarting Jenkins: 403 Forbidden"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceJenkinsSafeRestartCreate(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsSafeRestartCreate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
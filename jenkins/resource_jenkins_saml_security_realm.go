@@ -0,0 +1,236 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const samlSecurityRealmReadScript = `
+	def realm = jenkins.model.Jenkins.get().securityRealm
+	if (!(realm instanceof org.jenkinsci.plugins.saml.SamlSecurityRealm)) {
+		return null
+	}
+	def idp = realm.idpMetadataConfiguration
+	def encryption = realm.encryptionData
+	return [
+		idp_metadata_xml:                          idp.xml ?: '',
+		idp_metadata_url:                          idp.url ?: '',
+		idp_metadata_refresh_period:               idp.period ?: 0,
+		display_name_attribute:                    realm.displayNameAttributeName ?: '',
+		group_attribute:                           realm.groupsAttributeName ?: '',
+		username_attribute:                        realm.usernameAttributeName ?: '',
+		email_attribute:                           realm.emailAttributeName ?: '',
+		maximum_authentication_lifetime:           realm.maximumAuthenticationLifetime,
+		logout_url:                                realm.logoutUrl ?: '',
+		username_case_conversion:                  realm.usernameCaseConversion,
+		binding:                                   realm.binding,
+		keystore_path:                             encryption?.keystorePath ?: '',
+		private_key_alias:                         encryption?.privateKeyAlias ?: '',
+		force_sign_redirect_binding_authn_request: encryption?.forceSignRedirectBindingAuthnRequest ?: false,
+		wants_assertions_signed:                   encryption?.wantsAssertionsSigned ?: false,
+	]
+`
+
+// The realm is built through its data-bound constructor, as the structs plugin does for pipeline steps,
+// because its constructor arguments have changed between releases of the plugin.
+const samlSecurityRealmUpdateScript = `
+	def args = [
+		idpMetadataConfiguration:      [xml: input.idp_metadata_xml, url: input.idp_metadata_url, period: input.idp_metadata_refresh_period as Long],
+		displayNameAttributeName:      input.display_name_attribute,
+		groupsAttributeName:           input.group_attribute,
+		usernameAttributeName:         input.username_attribute,
+		emailAttributeName:            input.email_attribute,
+		maximumAuthenticationLifetime: input.maximum_authentication_lifetime,
+		logoutUrl:                     input.logout_url,
+		usernameCaseConversion:        input.username_case_conversion,
+		binding:                       input.binding,
+	]
+	if (input.keystore_path) {
+		args.encryptionData = new org.jenkinsci.plugins.saml.SamlEncryptionData(
+			input.keystore_path,
+			hudson.util.Secret.fromString(input.keystore_password),
+			hudson.util.Secret.fromString(input.private_key_password),
+			input.private_key_alias,
+			input.force_sign_redirect_binding_authn_request,
+			input.wants_assertions_signed
+		)
+	}
+	def realm = org.jenkinsci.plugins.structs.describable.DescribableModel.of(org.jenkinsci.plugins.saml.SamlSecurityRealm).instantiate(args)
+
+	def instance = jenkins.model.Jenkins.get()
+	instance.securityRealm = realm
+	instance.save()
+`
+
+var samlSecurityRealmProperties = []string{
+	"idp_metadata_xml", "idp_metadata_url", "idp_metadata_refresh_period", "display_name_attribute", "group_attribute",
+	"username_attribute", "email_attribute", "maximum_authentication_lifetime", "logout_url", "username_case_conversion",
+	"binding", "keystore_path", "keystore_password", "private_key_alias", "private_key_password",
+	"force_sign_redirect_binding_authn_request", "wants_assertions_signed",
+}
+
+func resourceJenkinsSAMLSecurityRealm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsSAMLSecurityRealmCreate,
+		ReadContext:   resourceJenkinsSAMLSecurityRealmRead,
+		UpdateContext: resourceJenkinsSAMLSecurityRealmUpdate,
+		DeleteContext: resourceJenkinsSAMLSecurityRealmDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"idp_metadata_xml": {
+				Type:         schema.TypeString,
+				Description:  "The metadata XML document of the identity provider.",
+				Optional:     true,
+				ExactlyOneOf: []string{"idp_metadata_xml", "idp_metadata_url"},
+			},
+			"idp_metadata_url": {
+				Type:         schema.TypeString,
+				Description:  "The URL to download the metadata of the identity provider from.",
+				Optional:     true,
+				ExactlyOneOf: []string{"idp_metadata_xml", "idp_metadata_url"},
+			},
+			"idp_metadata_refresh_period": {
+				Type:        schema.TypeInt,
+				Description: "The number of minutes between downloads of the identity provider metadata. Set to 0 to only download it once.",
+				Optional:    true,
+				Default:     0,
+			},
+			"display_name_attribute": {
+				Type:        schema.TypeString,
+				Description: "The SAML attribute holding the display name of the user.",
+				Optional:    true,
+				Default:     "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+			},
+			"group_attribute": {
+				Type:        schema.TypeString,
+				Description: "The SAML attribute holding the groups of the user.",
+				Optional:    true,
+				Default:     "http://schemas.xmlsoap.org/claims/Group",
+			},
+			"username_attribute": {
+				Type:        schema.TypeString,
+				Description: "The SAML attribute holding the username. The NameID of the assertion is used when left unset.",
+				Optional:    true,
+			},
+			"email_attribute": {
+				Type:        schema.TypeString,
+				Description: "The SAML attribute holding the email address of the user.",
+				Optional:    true,
+			},
+			"maximum_authentication_lifetime": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds a user may stay logged in for since last authenticating with the identity provider.",
+				Optional:    true,
+				Default:     86400,
+			},
+			"logout_url": {
+				Type:        schema.TypeString,
+				Description: "The URL users are sent to after logging out of Jenkins.",
+				Optional:    true,
+			},
+			"username_case_conversion": {
+				Type:             schema.TypeString,
+				Description:      "How the case of usernames is converted, one of none, lowercase or uppercase.",
+				Optional:         true,
+				Default:          "none",
+				ValidateDiagFunc: validateUsernameCaseConversion,
+			},
+			"binding": {
+				Type:             schema.TypeString,
+				Description:      "The binding used to send authentication requests to the identity provider.",
+				Optional:         true,
+				Default:          "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+				ValidateDiagFunc: validateSAMLBinding,
+			},
+			"keystore_path": {
+				Type:        schema.TypeString,
+				Description: "The path on the controller of the keystore used to sign and encrypt SAML messages. A key is generated when left unset.",
+				Optional:    true,
+			},
+			"keystore_password": {
+				Type:        schema.TypeString,
+				Description: "The password of the keystore.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"private_key_alias": {
+				Type:        schema.TypeString,
+				Description: "The alias of the private key within the keystore.",
+				Optional:    true,
+			},
+			"private_key_password": {
+				Type:        schema.TypeString,
+				Description: "The password of the private key.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"force_sign_redirect_binding_authn_request": {
+				Type:        schema.TypeBool,
+				Description: "Whether to sign authentication requests sent through the redirect binding.",
+				Optional:    true,
+				Default:     false,
+			},
+			"wants_assertions_signed": {
+				Type:        schema.TypeBool,
+				Description: "Whether to require the assertions of the identity provider to be signed.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsSAMLSecurityRealmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, samlSecurityRealmProperties...)
+	if err := executeScript(ctx, client, samlSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring SAML security realm: %w", err))
+	}
+
+	d.SetId("saml-security-realm")
+	return resourceJenkinsSAMLSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsSAMLSecurityRealmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, samlSecurityRealmReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading SAML security realm: %w", err))
+	}
+
+	if len(output) == 0 {
+		// Jenkins has been switched to another security realm
+		log.Printf("[DEBUG] jenkins::read - Jenkins is no longer using the SAML security realm")
+		d.SetId("")
+		return nil
+	}
+
+	// The keystore passwords cannot be read back, so the configured values are kept
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsSAMLSecurityRealmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, samlSecurityRealmProperties...)
+	if err := executeScript(ctx, client, samlSecurityRealmUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring SAML security realm: %w", err))
+	}
+
+	return resourceJenkinsSAMLSecurityRealmRead(ctx, d, meta)
+}
+
+func resourceJenkinsSAMLSecurityRealmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Falling back to another security realm would lock out every SAML user, so the realm is left in place.
+	log.Printf("[DEBUG] jenkins::delete - Removing SAML security realm %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,63 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// There is no acceptance test, as switching the test instance over to SAML would lock out the remaining tests.
+
+func Test_resourceJenkinsSAMLSecurityRealmCreate(t *testing.T) {
+	var scripts []string
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			scripts = append(scripts, script)
+			return `{"result":{"idp_metadata_xml":"","idp_metadata_url":"https://idp.example.com/metadata","idp_metadata_refresh_period":60,"display_name_attribute":"name","group_attribute":"groups","username_attribute":"","email_attribute":"email","maximum_authentication_lifetime":86400,"logout_url":"","username_case_conversion":"lowercase","binding":"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect","keystore_path":"","private_key_alias":"","force_sign_redirect_binding_authn_request":false,"wants_assertions_signed":false}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsSAMLSecurityRealm().Schema, map[string]interface{}{
+		"idp_metadata_url":            "https://idp.example.com/metadata",
+		"idp_metadata_refresh_period": 60,
+		"display_name_attribute":      "name",
+		"group_attribute":             "groups",
+		"email_attribute":             "email",
+		"username_case_conversion":    "lowercase",
+	})
+
+	if got := resourceJenkinsSAMLSecurityRealmCreate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsSAMLSecurityRealmCreate() = %v, want nil", got)
+	}
+	if d.Id() != "saml-security-realm" {
+		t.Errorf("Expected ID %q but received %q", "saml-security-realm", d.Id())
+	}
+	if len(scripts) != 2 || !strings.Contains(scripts[0], `"idp_metadata_url":"https://idp.example.com/metadata"`) {
+		t.Errorf("Expected the update script to receive the metadata URL: %v", scripts)
+	}
+	if d.Get("idp_metadata_refresh_period").(int) != 60 {
+		t.Errorf("Expected idp_metadata_refresh_period 60 but received %v", d.Get("idp_metadata_refresh_period"))
+	}
+}
+
+func Test_resourceJenkinsSAMLSecurityRealmRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsSAMLSecurityRealm().Schema, map[string]interface{}{})
+	d.SetId("saml-security-realm")
+
+	if got := resourceJenkinsSAMLSecurityRealmRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsSAMLSecurityRealmRead() = %v, want nil", got)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected the resource to be removed once another realm is in use")
+	}
+}
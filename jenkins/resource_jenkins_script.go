@@ -0,0 +1,118 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceJenkinsScript() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsScriptCreate,
+		ReadContext:   resourceJenkinsScriptRead,
+		UpdateContext: resourceJenkinsScriptUpdate,
+		DeleteContext: resourceJenkinsScriptDelete,
+		CustomizeDiff: resourceJenkinsScriptCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"script": {
+				Type:        schema.TypeString,
+				Description: "The Groovy script to execute through the Jenkins script console.",
+				Required:    true,
+			},
+			"destroy_script": {
+				Type:        schema.TypeString,
+				Description: "An optional Groovy script to execute when the resource is destroyed.",
+				Optional:    true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that will cause the script to be executed again in-place when changed.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that will cause the resource to be replaced, running the destroy script first, when changed.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Description: "Anything printed by the script during its last execution.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output, err := executeUserScript(ctx, client, d.Get("script").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error executing script: %w", err))
+	}
+
+	d.SetId(resource.UniqueId())
+	log.Printf("[DEBUG] jenkins::create - script %q executed", d.Id())
+	if err := d.Set("output", output); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceJenkinsScriptRead(ctx, d, meta)
+}
+
+func resourceJenkinsScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Script executions leave nothing behind in Jenkins that could be refreshed
+	return nil
+}
+
+func resourceJenkinsScriptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Only changes to the script itself or its triggers warrant another execution
+	if d.HasChanges("script", "triggers") {
+		output, err := executeUserScript(ctx, client, d.Get("script").(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::update - Error executing script %q: %w", d.Id(), err))
+		}
+
+		if err := d.Set("output", output); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceJenkinsScriptRead(ctx, d, meta)
+}
+
+func resourceJenkinsScriptCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The output of a re-execution cannot be known until it has been applied
+	if d.Id() != "" && (d.HasChange("script") || d.HasChange("triggers")) {
+		return d.SetNewComputed("output")
+	}
+	return nil
+}
+
+func resourceJenkinsScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	script := d.Get("destroy_script").(string)
+	if script == "" {
+		return nil
+	}
+
+	// The output is not logged, as scripts run through the script console routinely print secrets
+	if _, err := executeUserScript(ctx, client, script); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error executing destroy script %q: %w", d.Id(), err))
+	}
+
+	log.Printf("[DEBUG] jenkins::delete - destroy script %q executed", d.Id())
+	return nil
+}
@@ -0,0 +1,112 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const scriptApprovalReadScript = `
+	def approval = org.jenkinsci.plugins.scriptsecurity.scripts.ScriptApproval.get()
+	return [
+		signatures:    approval.@approvedSignatures as List,
+		script_hashes: approval.@approvedScriptHashes as List,
+	]
+`
+
+// Approvals are only changed through the methods of ScriptApproval, which persist them and reconfigure the sandbox
+// so that revoked signatures stop being allowed right away. Script hashes can not be revoked one at a time, so they
+// are cleared and those that are kept approved again.
+const scriptApprovalUpdateScript = `
+	def approval = org.jenkinsci.plugins.scriptsecurity.scripts.ScriptApproval.get()
+	(approval.@approvedSignatures as List).findAll { !(it in input.signatures) }.each { approval.denyApprovedSignature(it) }
+	input.signatures.each { approval.approveSignature(it) }
+	if ((approval.@approvedScriptHashes as List).any { !(it in input.script_hashes) }) {
+		approval.clearApprovedScripts()
+	}
+	input.script_hashes.each { approval.approveScript(it) }
+`
+
+const scriptApprovalDeleteScript = `
+	def approval = org.jenkinsci.plugins.scriptsecurity.scripts.ScriptApproval.get()
+	(approval.@approvedSignatures as List).findAll { it in input.signatures }.each { approval.denyApprovedSignature(it) }
+	def kept = (approval.@approvedScriptHashes as List).findAll { !(it in input.script_hashes) }
+	if (kept.size() < approval.@approvedScriptHashes.size()) {
+		approval.clearApprovedScripts()
+		kept.each { approval.approveScript(it) }
+	}
+`
+
+func resourceJenkinsScriptApproval() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsScriptApprovalCreate,
+		ReadContext:   resourceJenkinsScriptApprovalRead,
+		UpdateContext: resourceJenkinsScriptApprovalUpdate,
+		DeleteContext: resourceJenkinsScriptApprovalDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"signatures": {
+				Type:        schema.TypeSet,
+				Description: "The complete set of approved method signatures, such as \"method java.lang.String trim\".",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"script_hashes": {
+				Type:        schema.TypeSet,
+				Description: "The complete set of approved script hashes.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceJenkinsScriptApprovalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "signatures", "script_hashes")
+	if err := executeScript(ctx, client, scriptApprovalUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error approving scripts: %w", err))
+	}
+
+	d.SetId("script-approval")
+	return resourceJenkinsScriptApprovalRead(ctx, d, meta)
+}
+
+func resourceJenkinsScriptApprovalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, scriptApprovalReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading script approvals: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsScriptApprovalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "signatures", "script_hashes")
+	if err := executeScript(ctx, client, scriptApprovalUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error approving scripts: %w", err))
+	}
+
+	return resourceJenkinsScriptApprovalRead(ctx, d, meta)
+}
+
+func resourceJenkinsScriptApprovalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "signatures", "script_hashes")
+	if err := executeScript(ctx, client, scriptApprovalDeleteScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error revoking script approvals: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package jenkins
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJenkinsScriptApproval_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_script_approval foo {
+				  signatures = ["method java.lang.String trim"]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_script_approval.foo", "id", "script-approval"),
+					resource.TestCheckResourceAttr("jenkins_script_approval.foo", "signatures.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_script_approval.foo", "script_hashes.#", "0"),
+				),
+			},
+			{
+				// Update by adding a second signature
+				Config: `
+				resource jenkins_script_approval foo {
+				  signatures = [
+				    "method java.lang.String trim",
+				    "staticMethod java.lang.Math max int int",
+				  ]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_script_approval.foo", "signatures.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,123 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsScript_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_script foo {
+				  script = "println('hello')"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jenkins_script.foo", "id"),
+					resource.TestCheckResourceAttr("jenkins_script.foo", "output", "hello\n"),
+				),
+			},
+			{
+				// Update by changing the triggers
+				Config: `
+				resource jenkins_script foo {
+				  script = "println('hello ' + Jenkins.instance.numExecutors)"
+
+				  triggers = {
+				    version = "2"
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jenkins_script.foo", "output"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsScriptCreate(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return userScriptError + "java.lang.IllegalStateException: no executors\n", nil
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceJenkinsScript().Schema, map[string]interface{}{
+		"script": "throw new IllegalStateException('no executors')",
+	})
+
+	want := diag.Diagnostics{
+		diag.Diagnostic{Summary: "jenkins::create - Error executing script: script failed: java.lang.IllegalStateException: no executors"},
+	}
+	if got := resourceJenkinsScriptCreate(context.Background(), d, meta); !reflect.DeepEqual(got, want) {
+		t.Errorf("resourceJenkinsScriptCreate() = %v, want %v", got, want)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected a failing script not to create the resource, but it has ID %q", d.Id())
+	}
+}
+
+func Test_resourceJenkinsScriptDelete(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name string
+		args args
+		want diag.Diagnostics
+	}{
+		{
+			name: "no-destroy-script",
+			args: args{
+				meta: &mockJenkinsClient{},
+				d:    schema.TestResourceDataRaw(t, resourceJenkinsScript().Schema, map[string]interface{}{}),
+			},
+		},
+		{
+			name: "destroy-script",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return "", nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsScript().Schema, map[string]interface{}{
+					"destroy_script": "println('bye')",
+				}),
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return "", fmt.Errorf("403 Forbidden")
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsScript().Schema, map[string]interface{}{
+					"destroy_script": "println('bye')",
+				}),
+			},
+			want: diag.Diagnostics{
+				diag.Diagnostic{Summary: "jenkins::delete - Error executing destroy script \"\": 403 Forbidden"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceJenkinsScriptDelete(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsScriptDelete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
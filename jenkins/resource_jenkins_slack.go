@@ -0,0 +1,116 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const slackReadScript = `
+	def slack = jenkins.model.Jenkins.get().getDescriptorByType(jenkins.plugins.slack.SlackNotifier.DescriptorImpl)
+	return [
+		team_domain:         slack.teamDomain ?: '',
+		token_credential_id: slack.tokenCredentialId ?: '',
+		default_channel:     slack.room ?: '',
+		base_url:            slack.baseUrl ?: '',
+		bot_user:            slack.botUser,
+	]
+`
+
+const slackUpdateScript = `
+	def slack = jenkins.model.Jenkins.get().getDescriptorByType(jenkins.plugins.slack.SlackNotifier.DescriptorImpl)
+	slack.teamDomain = input.team_domain ?: null
+	slack.tokenCredentialId = input.token_credential_id ?: null
+	slack.room = input.default_channel ?: null
+	slack.baseUrl = input.base_url ?: null
+	slack.botUser = input.bot_user ?: false
+	slack.save()
+`
+
+var slackProperties = []string{"team_domain", "token_credential_id", "default_channel", "base_url", "bot_user"}
+
+func resourceJenkinsSlack() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsSlackCreate,
+		ReadContext:   resourceJenkinsSlackRead,
+		UpdateContext: resourceJenkinsSlackUpdate,
+		DeleteContext: resourceJenkinsSlackDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"team_domain": {
+				Type:        schema.TypeString,
+				Description: "The Slack workspace subdomain, such as \"example\" for example.slack.com.",
+				Optional:    true,
+			},
+			"token_credential_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the secret text credential holding the Slack token.",
+				Required:    true,
+			},
+			"default_channel": {
+				Type:        schema.TypeString,
+				Description: "The channel or member ID notifications are sent to when a step does not specify one.",
+				Optional:    true,
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Description: "An alternative base URL for Slack compatible endpoints, used instead of the team domain.",
+				Optional:    true,
+			},
+			"bot_user": {
+				Type:        schema.TypeBool,
+				Description: "Whether the token belongs to a bot user.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceJenkinsSlackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, slackUpdateScript, scriptInput(d, slackProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring Slack: %w", err))
+	}
+
+	d.SetId("slack")
+	return resourceJenkinsSlackRead(ctx, d, meta)
+}
+
+func resourceJenkinsSlackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, slackReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading Slack: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsSlackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, slackUpdateScript, scriptInput(d, slackProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring Slack: %w", err))
+	}
+
+	return resourceJenkinsSlackRead(ctx, d, meta)
+}
+
+func resourceJenkinsSlackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// An empty input clears every setting
+	if err := executeScript(ctx, client, slackUpdateScript, map[string]interface{}{}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error clearing Slack: %w", err))
+	}
+
+	return nil
+}
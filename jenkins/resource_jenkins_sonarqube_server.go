@@ -0,0 +1,157 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const sonarqubeServerReadScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(hudson.plugins.sonar.SonarGlobalConfiguration)
+	def installation = config.installations.find { it.name == input.name }
+	if (installation == null) {
+		return null
+	}
+	return [
+		server_url:                     installation.serverUrl ?: '',
+		credentials_id:                 installation.credentialsId ?: '',
+		webhook_secret_id:              installation.webhookSecretId ?: '',
+		additional_properties:          installation.additionalProperties ?: '',
+		additional_analysis_properties: installation.additionalAnalysisProperties ?: '',
+	]
+`
+
+const sonarqubeServerUpdateScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(hudson.plugins.sonar.SonarGlobalConfiguration)
+	def installation = new hudson.plugins.sonar.SonarInstallation(
+		input.name,
+		input.server_url,
+		input.credentials_id ?: null,
+		null,
+		input.webhook_secret_id ?: null,
+		null,
+		input.additional_properties ?: null,
+		input.additional_analysis_properties ?: null,
+		new hudson.plugins.sonar.model.TriggersConfig()
+	)
+	def installations = config.installations as List
+	def index = installations.findIndexOf { it.name == input.name }
+	if (index < 0) {
+		installations.add(installation)
+	} else {
+		installations[index] = installation
+	}
+	config.installations = installations as hudson.plugins.sonar.SonarInstallation[]
+	config.save()
+`
+
+const sonarqubeServerDeleteScript = `
+	def config = jenkins.model.Jenkins.get().getDescriptorByType(hudson.plugins.sonar.SonarGlobalConfiguration)
+	config.installations = config.installations.findAll { it.name != input.name } as hudson.plugins.sonar.SonarInstallation[]
+	config.save()
+`
+
+var sonarqubeServerProperties = []string{"name", "server_url", "credentials_id", "webhook_secret_id", "additional_properties", "additional_analysis_properties"}
+
+func resourceJenkinsSonarQubeServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsSonarQubeServerCreate,
+		ReadContext:   resourceJenkinsSonarQubeServerRead,
+		UpdateContext: resourceJenkinsSonarQubeServerUpdate,
+		DeleteContext: resourceJenkinsSonarQubeServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the installation, as referenced by withSonarQubeEnv.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"server_url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the SonarQube server.",
+				Required:    true,
+			},
+			"credentials_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the secret text credential holding the SonarQube authentication token.",
+				Optional:    true,
+			},
+			"webhook_secret_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the secret text credential holding the secret used to validate SonarQube webhooks.",
+				Optional:    true,
+			},
+			"additional_properties": {
+				Type:        schema.TypeString,
+				Description: "Additional properties passed to the SonarQube Maven goal.",
+				Optional:    true,
+			},
+			"additional_analysis_properties": {
+				Type:        schema.TypeString,
+				Description: "Additional analysis properties, separated by semicolons.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsSonarQubeServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Get("name").(string)
+
+	if err := executeScript(ctx, client, sonarqubeServerUpdateScript, scriptInput(d, sonarqubeServerProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring SonarQube server %q: %w", name, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - SonarQube server %q configured", name)
+	d.SetId(name)
+
+	return resourceJenkinsSonarQubeServerRead(ctx, d, meta)
+}
+
+func resourceJenkinsSonarQubeServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, sonarqubeServerReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading SonarQube server %q: %w", d.Id(), err))
+	}
+
+	if len(output) == 0 {
+		// SonarQube server does not exist
+		d.SetId("")
+		return nil
+	}
+
+	output["name"] = d.Id()
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsSonarQubeServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, sonarqubeServerUpdateScript, scriptInput(d, sonarqubeServerProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring SonarQube server %q: %w", d.Id(), err))
+	}
+
+	return resourceJenkinsSonarQubeServerRead(ctx, d, meta)
+}
+
+func resourceJenkinsSonarQubeServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, sonarqubeServerDeleteScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error removing SonarQube server %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsSonarQubeServer_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_sonarqube_server foo {
+				  name       = "tf-acc-test-%s"
+				  server_url = "https://sonarqube.example.com"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_sonarqube_server.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_sonarqube_server.foo", "server_url", "https://sonarqube.example.com"),
+				),
+			},
+			{
+				// Update by adding analysis properties
+				Config: fmt.Sprintf(`
+				resource jenkins_sonarqube_server foo {
+				  name                           = "tf-acc-test-%s"
+				  server_url                     = "https://sonarqube.example.com"
+				  additional_analysis_properties = "sonar.sourceEncoding=UTF-8"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_sonarqube_server.foo", "additional_analysis_properties", "sonar.sourceEncoding=UTF-8"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsSonarQubeServerRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   diag.Diagnostics
+		wantID string
+	}{
+		{
+			name: "exists",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"server_url":"https://sonarqube.example.com","credentials_id":"","webhook_secret_id":"","additional_properties":"","additional_analysis_properties":""}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsSonarQubeServer().Schema, map[string]interface{}{}),
+			},
+			wantID: "sonar",
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsSonarQubeServer().Schema, map[string]interface{}{}),
+			},
+			wantID: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("sonar")
+			if got := resourceJenkinsSonarQubeServerRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsSonarQubeServerRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsSonarQubeServerRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+		})
+	}
+}
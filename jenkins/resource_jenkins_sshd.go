@@ -0,0 +1,120 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The SSHD module represents a disabled daemon by port -1 and a random port by port 0.
+const sshdReadScript = `
+	def port = org.jenkinsci.main.modules.sshd.SSHD.get().port
+	switch (port) {
+		case -1:
+			return [mode: 'disabled', port: 0]
+		case 0:
+			return [mode: 'random', port: 0]
+		default:
+			return [mode: 'fixed', port: port]
+	}
+`
+
+const sshdUpdateScript = `
+	def sshd = org.jenkinsci.main.modules.sshd.SSHD.get()
+	switch (input.mode) {
+		case 'disabled':
+			sshd.port = -1
+			break
+		case 'random':
+			sshd.port = 0
+			break
+		default:
+			sshd.port = input.port
+	}
+	sshd.save()
+`
+
+func resourceJenkinsSSHD() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsSSHDCreate,
+		ReadContext:   resourceJenkinsSSHDRead,
+		UpdateContext: resourceJenkinsSSHDUpdate,
+		DeleteContext: resourceJenkinsSSHDDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceJenkinsSSHDCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Type:             schema.TypeString,
+				Description:      "Whether the SSH daemon listens on a fixed or random port, or is disabled.",
+				Required:         true,
+				ValidateDiagFunc: validateSSHDMode,
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Description: "The port the SSH daemon listens on when using the fixed mode.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsSSHDCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	port := d.Get("port").(int)
+	if d.Get("mode").(string) == "fixed" {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("a port between 1 and 65535 must be set when using the fixed mode")
+		}
+	} else if port != 0 {
+		return fmt.Errorf("a port may only be set when using the fixed mode")
+	}
+	return nil
+}
+
+func resourceJenkinsSSHDCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, sshdUpdateScript, scriptInput(d, "mode", "port"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring SSHD: %w", err))
+	}
+
+	d.SetId("sshd")
+	return resourceJenkinsSSHDRead(ctx, d, meta)
+}
+
+func resourceJenkinsSSHDRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, sshdReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading SSHD: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsSSHDUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, sshdUpdateScript, scriptInput(d, "mode", "port"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring SSHD: %w", err))
+	}
+
+	return resourceJenkinsSSHDRead(ctx, d, meta)
+}
+
+func resourceJenkinsSSHDDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Restore the Jenkins default of the SSH daemon being disabled
+	input := map[string]interface{}{"mode": "disabled", "port": 0}
+	if err := executeScript(ctx, client, sshdUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error disabling SSHD: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsSSHD_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_sshd foo {
+				  mode = "fixed"
+				  port = 2222
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_sshd.foo", "id", "sshd"),
+					resource.TestCheckResourceAttr("jenkins_sshd.foo", "mode", "fixed"),
+					resource.TestCheckResourceAttr("jenkins_sshd.foo", "port", "2222"),
+				),
+			},
+			{
+				// Update by disabling the daemon
+				Config: `
+				resource jenkins_sshd foo {
+				  mode = "disabled"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_sshd.foo", "mode", "disabled"),
+					resource.TestCheckResourceAttr("jenkins_sshd.foo", "port", "0"),
+				),
+			},
+			{
+				Config: `
+				resource jenkins_sshd foo {
+				  mode = "random"
+				  port = 2222
+				}`,
+				ExpectError: regexp.MustCompile("a port may only be set when using the fixed mode"),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsSSHDRead(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		wantMode string
+		wantPort int
+	}{
+		{
+			name:     "fixed",
+			output:   `{"result":{"mode":"fixed","port":2222}}`,
+			wantMode: "fixed",
+			wantPort: 2222,
+		},
+		{
+			name:     "disabled",
+			output:   `{"result":{"mode":"disabled","port":0}}`,
+			wantMode: "disabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := &mockJenkinsClient{
+				mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+					return tt.output, nil
+				},
+			}
+
+			d := schema.TestResourceDataRaw(t, resourceJenkinsSSHD().Schema, map[string]interface{}{})
+			d.SetId("sshd")
+			if got := resourceJenkinsSSHDRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+				t.Errorf("resourceJenkinsSSHDRead() = %v, want nil", got)
+			}
+			if got := d.Get("mode").(string); got != tt.wantMode {
+				t.Errorf("resourceJenkinsSSHDRead() mode = %q, want %q", got, tt.wantMode)
+			}
+			if got := d.Get("port").(int); got != tt.wantPort {
+				t.Errorf("resourceJenkinsSSHDRead() port = %v, want %v", got, tt.wantPort)
+			}
+		})
+	}
+}
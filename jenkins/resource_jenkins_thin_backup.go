@@ -0,0 +1,211 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const thinBackupReadScript = `
+	def backup = org.jvnet.hudson.plugins.thinbackup.ThinBackupPluginImpl.get()
+	return [
+		backup_path:              backup.backupPath ?: '',
+		full_backup_schedule:     backup.fullBackupSchedule ?: '',
+		diff_backup_schedule:     backup.diffBackupSchedule ?: '',
+		max_stored_full:          backup.nrMaxStoredFull as int,
+		cleanup_diff:             backup.cleanupDiff,
+		move_old_backups_to_zip:  backup.moveOldBackupsToZipFile,
+		backup_build_results:     backup.backupBuildResults,
+		backup_build_archive:     backup.backupBuildArchive,
+		backup_user_contents:     backup.backupUserContents,
+		backup_next_build_number: backup.backupNextBuildNumber,
+		backup_plugin_archives:   backup.backupPluginArchives,
+		excluded_files_regex:     backup.excludedFilesRegex ?: '',
+		wait_for_idle:            backup.waitForIdle,
+		force_quiet_mode_timeout: backup.forceQuietModeTimeout,
+	]
+`
+
+const thinBackupUpdateScript = `
+	def backup = org.jvnet.hudson.plugins.thinbackup.ThinBackupPluginImpl.get()
+	backup.backupPath = input.backup_path
+	backup.fullBackupSchedule = input.full_backup_schedule
+	backup.diffBackupSchedule = input.diff_backup_schedule
+	backup.nrMaxStoredFull = input.max_stored_full
+	backup.cleanupDiff = input.cleanup_diff
+	backup.moveOldBackupsToZipFile = input.move_old_backups_to_zip
+	backup.backupBuildResults = input.backup_build_results
+	backup.backupBuildArchive = input.backup_build_archive
+	backup.backupUserContents = input.backup_user_contents
+	backup.backupNextBuildNumber = input.backup_next_build_number
+	backup.backupPluginArchives = input.backup_plugin_archives
+	backup.excludedFilesRegex = input.excluded_files_regex
+	backup.waitForIdle = input.wait_for_idle
+	backup.forceQuietModeTimeout = input.force_quiet_mode_timeout
+	backup.save()
+`
+
+// thinBackupUnscheduleScript only clears the schedules, so that no further backups are taken
+// while the existing backups and settings remain available for restores.
+const thinBackupUnscheduleScript = `
+	def backup = org.jvnet.hudson.plugins.thinbackup.ThinBackupPluginImpl.get()
+	backup.fullBackupSchedule = ''
+	backup.diffBackupSchedule = ''
+	backup.save()
+`
+
+var thinBackupProperties = []string{
+	"backup_path",
+	"full_backup_schedule",
+	"diff_backup_schedule",
+	"max_stored_full",
+	"cleanup_diff",
+	"move_old_backups_to_zip",
+	"backup_build_results",
+	"backup_build_archive",
+	"backup_user_contents",
+	"backup_next_build_number",
+	"backup_plugin_archives",
+	"excluded_files_regex",
+	"wait_for_idle",
+	"force_quiet_mode_timeout",
+}
+
+func resourceJenkinsThinBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsThinBackupCreate,
+		ReadContext:   resourceJenkinsThinBackupRead,
+		UpdateContext: resourceJenkinsThinBackupUpdate,
+		DeleteContext: resourceJenkinsThinBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"backup_path": {
+				Type:        schema.TypeString,
+				Description: "The directory on the controller that backups are written to.",
+				Required:    true,
+			},
+			"full_backup_schedule": {
+				Type:        schema.TypeString,
+				Description: "The cron schedule of full backups.",
+				Optional:    true,
+			},
+			"diff_backup_schedule": {
+				Type:        schema.TypeString,
+				Description: "The cron schedule of differential backups.",
+				Optional:    true,
+			},
+			"max_stored_full": {
+				Type:        schema.TypeInt,
+				Description: "The maximum number of full backups kept, or -1 for no limit.",
+				Optional:    true,
+				Default:     -1,
+			},
+			"cleanup_diff": {
+				Type:        schema.TypeBool,
+				Description: "Whether differential backups are removed once a new full backup is taken.",
+				Optional:    true,
+				Default:     false,
+			},
+			"move_old_backups_to_zip": {
+				Type:        schema.TypeBool,
+				Description: "Whether old backups are compressed into ZIP files.",
+				Optional:    true,
+				Default:     false,
+			},
+			"backup_build_results": {
+				Type:        schema.TypeBool,
+				Description: "Whether build results are included.",
+				Optional:    true,
+				Default:     true,
+			},
+			"backup_build_archive": {
+				Type:        schema.TypeBool,
+				Description: "Whether build artifacts are included.",
+				Optional:    true,
+				Default:     false,
+			},
+			"backup_user_contents": {
+				Type:        schema.TypeBool,
+				Description: "Whether the userContent directory is included.",
+				Optional:    true,
+				Default:     false,
+			},
+			"backup_next_build_number": {
+				Type:        schema.TypeBool,
+				Description: "Whether the next build number files of jobs are included.",
+				Optional:    true,
+				Default:     false,
+			},
+			"backup_plugin_archives": {
+				Type:        schema.TypeBool,
+				Description: "Whether the installed plugin archives are included.",
+				Optional:    true,
+				Default:     false,
+			},
+			"excluded_files_regex": {
+				Type:        schema.TypeString,
+				Description: "A regular expression of file names excluded from backups.",
+				Optional:    true,
+			},
+			"wait_for_idle": {
+				Type:        schema.TypeBool,
+				Description: "Whether backups wait for running builds to finish.",
+				Optional:    true,
+				Default:     true,
+			},
+			"force_quiet_mode_timeout": {
+				Type:        schema.TypeInt,
+				Description: "The number of minutes a waiting backup puts Jenkins into quiet mode after.",
+				Optional:    true,
+				Default:     120,
+			},
+		},
+	}
+}
+
+func resourceJenkinsThinBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, thinBackupUpdateScript, scriptInput(d, thinBackupProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring thinBackup: %w", err))
+	}
+
+	d.SetId("thin-backup")
+	return resourceJenkinsThinBackupRead(ctx, d, meta)
+}
+
+func resourceJenkinsThinBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, thinBackupReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading thinBackup: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsThinBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, thinBackupUpdateScript, scriptInput(d, thinBackupProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring thinBackup: %w", err))
+	}
+
+	return resourceJenkinsThinBackupRead(ctx, d, meta)
+}
+
+func resourceJenkinsThinBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, thinBackupUnscheduleScript, nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error unscheduling thinBackup: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsThinBackup_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_thin_backup foo {
+				  backup_path          = "/var/jenkins_home/backups"
+				  full_backup_schedule = "H 2 * * 0"
+				  diff_backup_schedule = "H 2 * * 1-6"
+				  max_stored_full      = 4
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_thin_backup.foo", "id", "thin-backup"),
+					resource.TestCheckResourceAttr("jenkins_thin_backup.foo", "backup_path", "/var/jenkins_home/backups"),
+					resource.TestCheckResourceAttr("jenkins_thin_backup.foo", "full_backup_schedule", "H 2 * * 0"),
+					resource.TestCheckResourceAttr("jenkins_thin_backup.foo", "max_stored_full", "4"),
+					resource.TestCheckResourceAttr("jenkins_thin_backup.foo", "wait_for_idle", "true"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsThinBackupRead(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			return `{"result":{"backup_path":"/backups","full_backup_schedule":"H 2 * * 0","diff_backup_schedule":"","max_stored_full":4,"cleanup_diff":true,"move_old_backups_to_zip":false,"backup_build_results":true,"backup_build_archive":false,"backup_user_contents":false,"backup_next_build_number":false,"backup_plugin_archives":false,"excluded_files_regex":"","wait_for_idle":true,"force_quiet_mode_timeout":60}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsThinBackup().Schema, map[string]interface{}{})
+	d.SetId("thin-backup")
+
+	if got := resourceJenkinsThinBackupRead(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsThinBackupRead() = %v, want nil", got)
+	}
+	if d.Get("max_stored_full").(int) != 4 {
+		t.Errorf("Expected max_stored_full 4 but received %v", d.Get("max_stored_full"))
+	}
+	if !d.Get("cleanup_diff").(bool) {
+		t.Errorf("Expected cleanup_diff to be true")
+	}
+	if d.Get("force_quiet_mode_timeout").(int) != 60 {
+		t.Errorf("Expected force_quiet_mode_timeout 60 but received %v", d.Get("force_quiet_mode_timeout"))
+	}
+}
@@ -0,0 +1,114 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The timestamp formats the Timestamper plugin ships with.
+const (
+	defaultTimestamperSystemTimeFormat  = "'<b>'HH:mm:ss'</b> '"
+	defaultTimestamperElapsedTimeFormat = "'<b>'HH:mm:ss.S'</b> '"
+)
+
+const timestamperReadScript = `
+	def config = hudson.plugins.timestamper.TimestamperConfig.get()
+	return [
+		all_pipelines:       config.allPipelines,
+		system_time_format:  config.systemTimeFormat,
+		elapsed_time_format: config.elapsedTimeFormat,
+	]
+`
+
+const timestamperUpdateScript = `
+	def config = hudson.plugins.timestamper.TimestamperConfig.get()
+	config.allPipelines = input.all_pipelines
+	config.systemTimeFormat = input.system_time_format
+	config.elapsedTimeFormat = input.elapsed_time_format
+	config.save()
+`
+
+func resourceJenkinsTimestamper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsTimestamperCreate,
+		ReadContext:   resourceJenkinsTimestamperRead,
+		UpdateContext: resourceJenkinsTimestamperUpdate,
+		DeleteContext: resourceJenkinsTimestamperDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"all_pipelines": {
+				Type:        schema.TypeBool,
+				Description: "Whether timestamps are enabled for all pipelines.",
+				Optional:    true,
+				Default:     false,
+			},
+			"system_time_format": {
+				Type:        schema.TypeString,
+				Description: "The java.text.SimpleDateFormat pattern used to display the system clock time.",
+				Optional:    true,
+				Default:     defaultTimestamperSystemTimeFormat,
+			},
+			"elapsed_time_format": {
+				Type:        schema.TypeString,
+				Description: "The org.apache.commons.lang.time.DurationFormatUtils pattern used to display the elapsed time.",
+				Optional:    true,
+				Default:     defaultTimestamperElapsedTimeFormat,
+			},
+		},
+	}
+}
+
+func resourceJenkinsTimestamperCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "all_pipelines", "system_time_format", "elapsed_time_format")
+	if err := executeScript(ctx, client, timestamperUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring timestamper: %w", err))
+	}
+
+	d.SetId("timestamper")
+	return resourceJenkinsTimestamperRead(ctx, d, meta)
+}
+
+func resourceJenkinsTimestamperRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, timestamperReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading timestamper: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsTimestamperUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "all_pipelines", "system_time_format", "elapsed_time_format")
+	if err := executeScript(ctx, client, timestamperUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring timestamper: %w", err))
+	}
+
+	return resourceJenkinsTimestamperRead(ctx, d, meta)
+}
+
+func resourceJenkinsTimestamperDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := map[string]interface{}{
+		"all_pipelines":       false,
+		"system_time_format":  defaultTimestamperSystemTimeFormat,
+		"elapsed_time_format": defaultTimestamperElapsedTimeFormat,
+	}
+	if err := executeScript(ctx, client, timestamperUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring timestamper: %w", err))
+	}
+
+	return nil
+}
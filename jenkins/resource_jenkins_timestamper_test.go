@@ -0,0 +1,54 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsTimestamper_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_timestamper foo {
+				  all_pipelines      = true
+				  system_time_format = "yyyy-MM-dd HH:mm:ss' '"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_timestamper.foo", "id", "timestamper"),
+					resource.TestCheckResourceAttr("jenkins_timestamper.foo", "all_pipelines", "true"),
+					resource.TestCheckResourceAttr("jenkins_timestamper.foo", "system_time_format", "yyyy-MM-dd HH:mm:ss' '"),
+					resource.TestCheckResourceAttr("jenkins_timestamper.foo", "elapsed_time_format", defaultTimestamperElapsedTimeFormat),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsTimestamperDelete(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if !strings.Contains(script, `"all_pipelines":false`) || !strings.Contains(script, `<b>`) {
+				t.Errorf("Expected the plugin defaults to be restored: %s", script)
+			}
+			return `{"result":null}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsTimestamper().Schema, map[string]interface{}{
+		"all_pipelines": true,
+	})
+	d.SetId("timestamper")
+
+	if got := resourceJenkinsTimestamperDelete(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsTimestamperDelete() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,129 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultUpdateSiteURL is the location the "default" update site is restored to when it is no longer managed.
+const defaultUpdateSiteURL = "https://updates.jenkins.io/update-center.json"
+
+const updateSiteReadScript = `
+	def site = jenkins.model.Jenkins.get().updateCenter.getById(input.name)
+	if (site == null) {
+		return null
+	}
+	return [url: site.url]
+`
+
+const updateSiteUpdateScript = `
+	def uc = jenkins.model.Jenkins.get().updateCenter
+	def site = new hudson.model.UpdateSite(input.name, input.url)
+	def existing = uc.getById(input.name)
+	if (existing == null) {
+		uc.sites.add(site)
+	} else {
+		uc.sites.replace(existing, site)
+	}
+	site.doInvalidateData()
+`
+
+const updateSiteDeleteScript = `
+	def uc = jenkins.model.Jenkins.get().updateCenter
+	def existing = uc.getById(input.name)
+	if (existing != null) {
+		uc.sites.remove(existing)
+	}
+	if (input.url) {
+		uc.sites.add(new hudson.model.UpdateSite(input.name, input.url))
+	}
+`
+
+func resourceJenkinsUpdateSite() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsUpdateSiteCreate,
+		ReadContext:   resourceJenkinsUpdateSiteRead,
+		UpdateContext: resourceJenkinsUpdateSiteUpdate,
+		DeleteContext: resourceJenkinsUpdateSiteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The identifier of the update site. Use \"default\" to replace the Jenkins update site.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the update site's update-center.json document.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsUpdateSiteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	name := d.Get("name").(string)
+
+	if err := executeScript(ctx, client, updateSiteUpdateScript, scriptInput(d, "name", "url"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring update site %q: %w", name, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - update site %q configured", name)
+	d.SetId(name)
+
+	return resourceJenkinsUpdateSiteRead(ctx, d, meta)
+}
+
+func resourceJenkinsUpdateSiteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"name": d.Id()}
+	if err := executeScript(ctx, client, updateSiteReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading update site %q: %w", d.Id(), err))
+	}
+
+	if len(output) == 0 {
+		// Update site does not exist
+		d.SetId("")
+		return nil
+	}
+
+	output["name"] = d.Id()
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsUpdateSiteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, updateSiteUpdateScript, scriptInput(d, "name", "url"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring update site %q: %w", d.Id(), err))
+	}
+
+	return resourceJenkinsUpdateSiteRead(ctx, d, meta)
+}
+
+func resourceJenkinsUpdateSiteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Jenkins cannot install plugins without its default update site, so that is restored rather than removed
+	input := map[string]interface{}{"name": d.Id(), "url": ""}
+	if d.Id() == "default" {
+		input["url"] = defaultUpdateSiteURL
+	}
+
+	if err := executeScript(ctx, client, updateSiteDeleteScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error removing update site %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
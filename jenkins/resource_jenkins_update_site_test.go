@@ -0,0 +1,98 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsUpdateSite_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_update_site foo {
+				  name = "tf-acc-test-%s"
+				  url  = "https://updates.example.com/update-center.json"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_update_site.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_update_site.foo", "url", "https://updates.example.com/update-center.json"),
+				),
+			},
+			{
+				// Update by changing the URL
+				Config: fmt.Sprintf(`
+				resource jenkins_update_site foo {
+				  name = "tf-acc-test-%s"
+				  url  = "https://mirror.example.com/update-center.json"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_update_site.foo", "url", "https://mirror.example.com/update-center.json"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsUpdateSiteDelete(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		id   string
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name string
+		args args
+		want diag.Diagnostics
+	}{
+		{
+			name: "custom",
+			args: args{
+				id: "mirror",
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						if strings.Contains(script, defaultUpdateSiteURL) {
+							t.Errorf("Expected custom update sites not to be restored: %s", script)
+						}
+						return `{"result":null}`, nil
+					},
+				},
+			},
+		},
+		{
+			name: "default",
+			args: args{
+				id: "default",
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						if !strings.Contains(script, defaultUpdateSiteURL) {
+							t.Errorf("Expected the default update site to be restored: %s", script)
+						}
+						return `{"result":null}`, nil
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceJenkinsUpdateSite().Schema, map[string]interface{}{})
+			d.SetId(tt.args.id)
+			if got := resourceJenkinsUpdateSiteDelete(tt.args.ctx, d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsUpdateSiteDelete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const usageStatisticsReadScript = `
+	return [enabled: jenkins.model.Jenkins.get().isUsageStatisticsCollected()]
+`
+
+const usageStatisticsUpdateScript = `
+	def instance = jenkins.model.Jenkins.get()
+	instance.noUsageStatistics = !input.enabled
+	instance.save()
+`
+
+func resourceJenkinsUsageStatistics() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsUsageStatisticsCreate,
+		ReadContext:   resourceJenkinsUsageStatisticsRead,
+		UpdateContext: resourceJenkinsUsageStatisticsUpdate,
+		DeleteContext: resourceJenkinsUsageStatisticsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether anonymous usage statistics are submitted to the Jenkins project.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsUsageStatisticsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, usageStatisticsUpdateScript, scriptInput(d, "enabled"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error configuring usage statistics: %w", err))
+	}
+
+	d.SetId("usage-statistics")
+	return resourceJenkinsUsageStatisticsRead(ctx, d, meta)
+}
+
+func resourceJenkinsUsageStatisticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	if err := executeScript(ctx, client, usageStatisticsReadScript, nil, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading usage statistics: %w", err))
+	}
+
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsUsageStatisticsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	if err := executeScript(ctx, client, usageStatisticsUpdateScript, scriptInput(d, "enabled"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error configuring usage statistics: %w", err))
+	}
+
+	return resourceJenkinsUsageStatisticsRead(ctx, d, meta)
+}
+
+func resourceJenkinsUsageStatisticsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Restore the Jenkins default of submitting usage statistics
+	input := map[string]interface{}{"enabled": true}
+	if err := executeScript(ctx, client, usageStatisticsUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error restoring usage statistics: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package jenkins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsUsageStatistics_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_usage_statistics foo {
+				  enabled = false
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_usage_statistics.foo", "id", "usage-statistics"),
+					resource.TestCheckResourceAttr("jenkins_usage_statistics.foo", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsUsageStatisticsCreate(t *testing.T) {
+	var scripts []string
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			scripts = append(scripts, script)
+			return `{"result":{"enabled":false}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsUsageStatistics().Schema, map[string]interface{}{
+		"enabled": false,
+	})
+
+	if got := resourceJenkinsUsageStatisticsCreate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsUsageStatisticsCreate() = %v, want nil", got)
+	}
+	if d.Id() != "usage-statistics" {
+		t.Errorf("Expected ID %q but received %q", "usage-statistics", d.Id())
+	}
+	if len(scripts) != 2 || !strings.Contains(scripts[0], `{"enabled":false}`) {
+		t.Errorf("Expected the update script to receive the desired setting: %v", scripts)
+	}
+	if d.Get("enabled").(bool) {
+		t.Errorf("Expected usage statistics to be disabled")
+	}
+}
@@ -0,0 +1,180 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The Mailer plugin that stores email addresses is optional, so its classes are looked up at runtime.
+const userReadScript = `
+	def user = hudson.model.User.getById(input.username, false)
+	if (user == null || user.getProperty(hudson.security.HudsonPrivateSecurityRealm.Details) == null) {
+		return null
+	}
+	def email = ''
+	try {
+		def mailer = jenkins.model.Jenkins.get().pluginManager.uberClassLoader.loadClass('hudson.tasks.Mailer$UserProperty')
+		email = user.getProperty(mailer)?.configuredAddress ?: ''
+	} catch (ClassNotFoundException e) {
+	}
+	return [
+		full_name:   user.fullName,
+		email:       email,
+		description: user.description ?: '',
+	]
+`
+
+const userUpdateScript = `
+	def realm = jenkins.model.Jenkins.get().securityRealm
+	if (!(realm instanceof hudson.security.HudsonPrivateSecurityRealm)) {
+		throw new IllegalStateException("Jenkins is not using its own user database")
+	}
+
+	def user = hudson.model.User.getById(input.username, false)
+	if (user == null || user.getProperty(hudson.security.HudsonPrivateSecurityRealm.Details) == null) {
+		user = realm.createAccount(input.username, java.util.UUID.randomUUID().toString())
+	}
+	if (input.password_hash) {
+		user.addProperty(hudson.security.HudsonPrivateSecurityRealm.Details.fromHashedPassword(input.password_hash))
+	} else if (input.password) {
+		user.addProperty(hudson.security.HudsonPrivateSecurityRealm.Details.fromPlainPassword(input.password))
+	}
+	user.fullName = input.full_name ?: input.username
+	user.description = input.description ?: null
+	// An address that is no longer configured is cleared, rather than left in place by omitting the property
+	try {
+		def mailer = jenkins.model.Jenkins.get().pluginManager.uberClassLoader.loadClass('hudson.tasks.Mailer$UserProperty')
+		user.addProperty(mailer.getConstructor(String).newInstance([input.email ?: null] as Object[]))
+	} catch (ClassNotFoundException e) {
+		if (input.email) {
+			throw e
+		}
+	}
+	user.save()
+`
+
+const userDeleteScript = `
+	def user = hudson.model.User.getById(input.username, false)
+	if (user != null) {
+		user.delete()
+	}
+`
+
+var userProperties = []string{"username", "password", "password_hash", "full_name", "email", "description"}
+
+func resourceJenkinsUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsUserCreate,
+		ReadContext:   resourceJenkinsUserRead,
+		UpdateContext: resourceJenkinsUserUpdate,
+		DeleteContext: resourceJenkinsUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the account.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Description:   "The password of the account. Omit both this and password_hash for accounts that should not log in with a password, which are given a random password that cannot be recovered.",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_hash"},
+			},
+			"password_hash": {
+				Type:          schema.TypeString,
+				Description:   "The hashed password of the account, in the Jenkins \"#jbcrypt:\" format.",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password"},
+			},
+			"full_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of the account. Defaults to the username.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address of the account. Requires the Mailer plugin.",
+				Optional:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "A description of the account.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceJenkinsUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	username := d.Get("username").(string)
+
+	if err := executeScript(ctx, client, userUpdateScript, scriptInput(d, userProperties...), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error creating user %q: %w", username, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - user %q created", username)
+	d.SetId(username)
+
+	return resourceJenkinsUserRead(ctx, d, meta)
+}
+
+func resourceJenkinsUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Passwords cannot be read back, so changes to them made outside of Terraform are not detected
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"username": d.Id()}
+	if err := executeScript(ctx, client, userReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading user %q: %w", d.Id(), err))
+	}
+
+	if len(output) == 0 {
+		// User does not exist
+		d.SetId("")
+		return nil
+	}
+
+	output["username"] = d.Id()
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	// Only send a password when it has changed, so that one set through the UI is not reset on every update
+	input := scriptInput(d, userProperties...)
+	if !d.HasChange("password") && !d.HasChange("password_hash") {
+		delete(input, "password")
+		delete(input, "password_hash")
+	}
+
+	if err := executeScript(ctx, client, userUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error updating user %q: %w", d.Id(), err))
+	}
+
+	return resourceJenkinsUserRead(ctx, d, meta)
+}
+
+func resourceJenkinsUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := map[string]interface{}{"username": d.Id()}
+	if err := executeScript(ctx, client, userDeleteScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error deleting user %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The keys are held by a user property of the SSHD module, which is not always installed, so its class is looked up at runtime.
+const userSSHKeysPrelude = `
+	def property = jenkins.model.Jenkins.get().pluginManager.uberClassLoader.loadClass('org.jenkinsci.main.modules.cli.auth.ssh.UserPropertyImpl')
+`
+
+const userSSHKeysReadScript = userSSHKeysPrelude + `
+	def user = hudson.model.User.getById(input.username, false)
+	if (user == null) {
+		return null
+	}
+	return [
+		authorized_keys: (user.getProperty(property)?.authorizedKeys ?: '').readLines()*.trim().findAll { it },
+	]
+`
+
+const userSSHKeysUpdateScript = userSSHKeysPrelude + `
+	def user = hudson.model.User.getById(input.username, true)
+	user.addProperty(property.newInstance(input.authorized_keys.join('\n')))
+	user.save()
+`
+
+func resourceJenkinsUserSSHKeys() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJenkinsUserSSHKeysCreate,
+		ReadContext:   resourceJenkinsUserSSHKeysRead,
+		UpdateContext: resourceJenkinsUserSSHKeysUpdate,
+		DeleteContext: resourceJenkinsUserSSHKeysDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The ID of the user whose keys are managed.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"authorized_keys": {
+				Type:        schema.TypeList,
+				Description: "The complete list of SSH public keys the user may authenticate with, in OpenSSH authorized_keys format.",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceJenkinsUserSSHKeysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+	username := d.Get("username").(string)
+
+	input := scriptInput(d, "username", "authorized_keys")
+	if err := executeScript(ctx, client, userSSHKeysUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error setting SSH keys of user %q: %w", username, err))
+	}
+
+	log.Printf("[DEBUG] jenkins::create - SSH keys of user %q set", username)
+	d.SetId(username)
+
+	return resourceJenkinsUserSSHKeysRead(ctx, d, meta)
+}
+
+func resourceJenkinsUserSSHKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	output := map[string]interface{}{}
+	input := map[string]interface{}{"username": d.Id()}
+	if err := executeScript(ctx, client, userSSHKeysReadScript, input, &output); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::read - Error reading SSH keys of user %q: %w", d.Id(), err))
+	}
+
+	if len(output) == 0 {
+		// User does not exist
+		d.SetId("")
+		return nil
+	}
+
+	output["username"] = d.Id()
+	return setScriptOutput(d, output)
+}
+
+func resourceJenkinsUserSSHKeysUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := scriptInput(d, "username", "authorized_keys")
+	if err := executeScript(ctx, client, userSSHKeysUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::update - Error setting SSH keys of user %q: %w", d.Id(), err))
+	}
+
+	return resourceJenkinsUserSSHKeysRead(ctx, d, meta)
+}
+
+func resourceJenkinsUserSSHKeysDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(jenkinsClient)
+
+	input := map[string]interface{}{"username": d.Id(), "authorized_keys": []string{}}
+	if err := executeScript(ctx, client, userSSHKeysUpdateScript, input, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::delete - Error removing SSH keys of user %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
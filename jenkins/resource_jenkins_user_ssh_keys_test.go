@@ -0,0 +1,94 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccJenkinsUserSSHKeys_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_user foo {
+				  username = "tf-acc-test-%s"
+				  password = "hunter2"
+				}
+
+				resource jenkins_user_ssh_keys foo {
+				  username        = jenkins_user.foo.username
+				  authorized_keys = ["ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl bot@example.com"]
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_user_ssh_keys.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_user_ssh_keys.foo", "authorized_keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsUserSSHKeysRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     diag.Diagnostics
+		wantID   string
+		wantKeys int
+	}{
+		{
+			name: "exists",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"authorized_keys":["ssh-ed25519 AAAA bot@example.com","ssh-rsa BBBB bot@example.com"]}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsUserSSHKeys().Schema, map[string]interface{}{}),
+			},
+			wantID:   "bot",
+			wantKeys: 2,
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsUserSSHKeys().Schema, map[string]interface{}{}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("bot")
+			if got := resourceJenkinsUserSSHKeysRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsUserSSHKeysRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsUserSSHKeysRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+			if got := len(tt.args.d.Get("authorized_keys").([]interface{})); got != tt.wantKeys {
+				t.Errorf("resourceJenkinsUserSSHKeysRead() keys = %v, want %v", got, tt.wantKeys)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJenkinsUser_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_user foo {
+				  username = "tf-acc-test-%s"
+				  password = "hunter2"
+				  email    = "bot@example.com"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_user.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_user.foo", "full_name", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_user.foo", "email", "bot@example.com"),
+				),
+			},
+			{
+				// Update by renaming the account
+				Config: fmt.Sprintf(`
+				resource jenkins_user foo {
+				  username    = "tf-acc-test-%s"
+				  password    = "hunter2"
+				  full_name   = "Deploy Bot"
+				  email       = "bot@example.com"
+				  description = "Managed by Terraform"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_user.foo", "full_name", "Deploy Bot"),
+					resource.TestCheckResourceAttr("jenkins_user.foo", "description", "Managed by Terraform"),
+				),
+			},
+			{
+				// Update by removing the email address
+				Config: fmt.Sprintf(`
+				resource jenkins_user foo {
+				  username    = "tf-acc-test-%s"
+				  password    = "hunter2"
+				  full_name   = "Deploy Bot"
+				  description = "Managed by Terraform"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_user.foo", "email", ""),
+				),
+			},
+		},
+	})
+}
+
+func Test_resourceJenkinsUserRead(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		d    *schema.ResourceData
+		meta jenkinsClient
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   diag.Diagnostics
+		wantID string
+	}{
+		{
+			name: "exists",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":{"full_name":"Deploy Bot","email":"","description":""}}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsUser().Schema, map[string]interface{}{}),
+			},
+			wantID: "bot",
+		},
+		{
+			name: "missing",
+			args: args{
+				meta: &mockJenkinsClient{
+					mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+						return `{"result":null}`, nil
+					},
+				},
+				d: schema.TestResourceDataRaw(t, resourceJenkinsUser().Schema, map[string]interface{}{}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.d.SetId("bot")
+			if got := resourceJenkinsUserRead(tt.args.ctx, tt.args.d, tt.args.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceJenkinsUserRead() = %v, want %v", got, tt.want)
+			}
+			if tt.args.d.Id() != tt.wantID {
+				t.Errorf("resourceJenkinsUserRead() ID = %q, want %q", tt.args.d.Id(), tt.wantID)
+			}
+		})
+	}
+}
+
+func Test_resourceJenkinsUserCreate(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if strings.Contains(script, "createAccount") {
+				// Accounts without a password are given a random one, as the user database requires one
+				if !strings.Contains(script, `"password":""`) || !strings.Contains(script, `"password_hash":""`) {
+					t.Errorf("Expected no password to be sent: %s", script)
+				}
+				if !strings.Contains(script, "realm.createAccount(input.username, java.util.UUID.randomUUID().toString())") {
+					t.Errorf("Expected the account to be created with a random password: %s", script)
+				}
+				return `{"result":null}`, nil
+			}
+			return `{"result":{"full_name":"bot","email":"","description":""}}`, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceJenkinsUser().Schema, map[string]interface{}{
+		"username": "bot",
+	})
+
+	if got := resourceJenkinsUserCreate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsUserCreate() = %v, want nil", got)
+	}
+	if d.Id() != "bot" || d.Get("password").(string) != "" {
+		t.Errorf("Expected user %q to be created without a password, received %q", "bot", d.Id())
+	}
+}
+
+func Test_resourceJenkinsUserUpdate(t *testing.T) {
+	meta := &mockJenkinsClient{
+		mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+			if strings.Contains(script, "hunter2") {
+				t.Errorf("Expected an unchanged password not to be sent: %s", script)
+			}
+			return `{"result":{"full_name":"Deploy Bot","email":"","description":""}}`, nil
+		},
+	}
+
+	// Existing state without any pending changes to the password
+	d := resourceJenkinsUser().Data(&terraform.InstanceState{
+		ID: "bot",
+		Attributes: map[string]string{
+			"username": "bot",
+			"password": "hunter2",
+		},
+	})
+
+	if got := resourceJenkinsUserUpdate(context.Background(), d, meta); !reflect.DeepEqual(got, diag.Diagnostics(nil)) {
+		t.Errorf("resourceJenkinsUserUpdate() = %v, want nil", got)
+	}
+}
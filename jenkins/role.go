@@ -0,0 +1,139 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The role scripts are shared by each type of role, which is given through the "type" input as one of
+// the Global, Project or Slave role types of the Role Strategy plugin.
+const roleStrategyPrelude = `
+	def strategy = jenkins.model.Jenkins.get().authorizationStrategy
+	if (!(strategy instanceof com.michelin.cio.hudson.plugins.rolestrategy.RoleBasedAuthorizationStrategy)) {
+		throw new IllegalStateException('Jenkins is not using the role-based authorization strategy')
+	}
+	def roles = strategy.getRoleMap(com.synopsys.arc.jenkins.plugins.rolestrategy.RoleType.valueOf(input.type))
+`
+
+const roleReadScript = roleStrategyPrelude + `
+	def role = roles.getRole(input.name)
+	if (role == null) {
+		return null
+	}
+	def result = [
+		permissions: role.permissions.collect { it.id },
+		sids:        (roles.getSidsForRole(role.name) ?: []) as List,
+	]
+	if (input.type != 'Global') {
+		result.pattern = role.pattern.pattern()
+	}
+	return result
+`
+
+const roleUpdateScript = roleStrategyPrelude + `
+	def permissions = input.permissions.collect { id ->
+		def permission = hudson.security.Permission.fromId(id)
+		if (permission == null) {
+			throw new IllegalArgumentException("Unknown permission: " + id)
+		}
+		return permission
+	} as Set
+
+	def existing = roles.getRole(input.name)
+	if (existing != null) {
+		roles.removeRole(existing)
+	}
+	def role = new com.michelin.cio.hudson.plugins.rolestrategy.Role(input.name, input.pattern ?: '.*', permissions)
+	roles.addRole(role)
+	input.sids.each { sid -> roles.assignRole(role, sid) }
+	jenkins.model.Jenkins.get().save()
+`
+
+const roleDeleteScript = roleStrategyPrelude + `
+	def role = roles.getRole(input.name)
+	if (role != null) {
+		roles.removeRole(role)
+		jenkins.model.Jenkins.get().save()
+	}
+`
+
+// roleScriptInput collects the properties of a role resource, where only non-global roles have a pattern.
+func roleScriptInput(d *schema.ResourceData, roleType string) map[string]interface{} {
+	keys := []string{"name", "permissions", "sids"}
+	if roleType != "Global" {
+		keys = append(keys, "pattern")
+	}
+
+	input := scriptInput(d, keys...)
+	input["type"] = roleType
+	return input
+}
+
+// The role resources only differ in their role type and schema, so their operations are built here.
+// The description is used to tell roles apart in messages, such as "global role".
+
+func createRole(roleType, description string) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(jenkinsClient)
+		name := d.Get("name").(string)
+
+		if err := executeScript(ctx, client, roleUpdateScript, roleScriptInput(d, roleType), nil); err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::create - Error creating %s %q: %w", description, name, err))
+		}
+
+		log.Printf("[DEBUG] jenkins::create - %s %q created", description, name)
+		d.SetId(name)
+
+		return readRole(roleType, description)(ctx, d, meta)
+	}
+}
+
+func readRole(roleType, description string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(jenkinsClient)
+
+		output := map[string]interface{}{}
+		input := map[string]interface{}{"name": d.Id(), "type": roleType}
+		if err := executeScript(ctx, client, roleReadScript, input, &output); err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::read - Error reading %s %q: %w", description, d.Id(), err))
+		}
+
+		if len(output) == 0 {
+			// Role does not exist
+			d.SetId("")
+			return nil
+		}
+
+		output["name"] = d.Id()
+		return setScriptOutput(d, output)
+	}
+}
+
+func updateRole(roleType, description string) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(jenkinsClient)
+
+		if err := executeScript(ctx, client, roleUpdateScript, roleScriptInput(d, roleType), nil); err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::update - Error updating %s %q: %w", description, d.Id(), err))
+		}
+
+		return readRole(roleType, description)(ctx, d, meta)
+	}
+}
+
+func deleteRole(roleType, description string) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(jenkinsClient)
+
+		input := map[string]interface{}{"name": d.Id(), "type": roleType}
+		if err := executeScript(ctx, client, roleDeleteScript, input, nil); err != nil {
+			return diag.FromErr(fmt.Errorf("jenkins::delete - Error deleting %s %q: %w", description, d.Id(), err))
+		}
+
+		return nil
+	}
+}
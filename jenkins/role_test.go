@@ -0,0 +1,25 @@
+package jenkins
+
+import (
+	"testing"
+)
+
+func TestRoleScriptInput(t *testing.T) {
+	d := resourceJenkinsItemRole().TestResourceData()
+	d.Set("name", "team-a")
+	d.Set("pattern", "^team-a/.*")
+
+	input := roleScriptInput(d, "Project")
+	if input["type"] != "Project" || input["pattern"] != "^team-a/.*" {
+		t.Errorf("Expected the role type and pattern to be included but received %v", input)
+	}
+
+	// Global roles apply everywhere and have no pattern
+	d = resourceJenkinsGlobalRole().TestResourceData()
+	d.Set("name", "readers")
+
+	input = roleScriptInput(d, "Global")
+	if _, ok := input["pattern"]; ok {
+		t.Errorf("Expected no pattern for global roles but received %v", input)
+	}
+}
@@ -0,0 +1,141 @@
+package jenkins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scriptWrapper surrounds every Groovy script run by the provider. The script body is given the
+// decoded "input" variable and its return value is printed back as JSON, as are any exceptions,
+// so that results can be reliably told apart from whatever else Jenkins writes to the console.
+const scriptWrapper = `def input = new groovy.json.JsonSlurper().parseText(%s)
+try {
+	def result = { ->
+%s
+	}()
+	println(groovy.json.JsonOutput.toJson([result: result]))
+} catch (Throwable e) {
+	println(groovy.json.JsonOutput.toJson([error: e.toString()]))
+}
+`
+
+type scriptResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+// executeScript runs one of the provider's Groovy scripts through the Jenkins script console.
+// The input is made available to the script as the "input" variable, and the value returned
+// by the script is decoded into output. Output may be nil if the result is not needed.
+func executeScript(ctx context.Context, client jenkinsClient, script string, input interface{}, output interface{}) error {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(input); err != nil {
+		return fmt.Errorf("could not encode script input: %w", err)
+	}
+
+	raw, err := client.ExecuteScript(ctx, fmt.Sprintf(scriptWrapper, groovyString(strings.TrimSpace(buf.String())), script))
+	if err != nil {
+		return err
+	}
+
+	// The wrapper emits its response last, so ignore anything else the script may have printed
+	lines := strings.Split(strings.TrimSpace(raw), "\n")
+	resp := scriptResponse{}
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &resp); err != nil {
+		// The output itself is not logged, as it may hold the secrets a script has read or printed
+		log.Printf("[DEBUG] jenkins::script - Unexpected script output of %d bytes", len(raw))
+		return fmt.Errorf("could not parse script output: %w", err)
+	}
+
+	if resp.Error != "" {
+		return fmt.Errorf("script failed: %s", resp.Error)
+	}
+
+	if output == nil || len(resp.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, output)
+}
+
+// userScriptWrapper runs a script given by the user unchanged, within a shell set up like that of the script console,
+// so that its imports and anything it prints are kept. The script console responds successfully to scripts that
+// throw, so any exception is printed on a last line starting with userScriptError instead.
+const userScriptWrapper = `def config = new org.codehaus.groovy.control.CompilerConfiguration()
+config.addCompilationCustomizers(new org.codehaus.groovy.control.customizers.ImportCustomizer().addStarImports('jenkins', 'jenkins.model', 'hudson', 'hudson.model'))
+try {
+	new GroovyShell(jenkins.model.Jenkins.get().pluginManager.uberClassLoader, binding, config).evaluate(%s)
+} catch (Throwable e) {
+	println(%s + e.toString())
+}
+`
+
+const userScriptError = "terraform-provider-jenkins: script failed: "
+
+// executeUserScript runs a script given by the user through the Jenkins script console, returning anything it
+// printed. Exceptions thrown by the script are returned as an error.
+func executeUserScript(ctx context.Context, client jenkinsClient, script string) (string, error) {
+	output, err := client.ExecuteScript(ctx, fmt.Sprintf(userScriptWrapper, groovyString(script), groovyString(userScriptError)))
+	if err != nil {
+		return "", err
+	}
+
+	if i := strings.LastIndex(output, userScriptError); i >= 0 && (i == 0 || output[i-1] == '\n') {
+		return output[:i], fmt.Errorf("script failed: %s", strings.TrimSpace(output[i+len(userScriptError):]))
+	}
+	return output, nil
+}
+
+// groovyString quotes a value as a single-quoted (and therefore non-interpolated) Groovy string literal.
+func groovyString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`).Replace(s) + "'"
+}
+
+// scriptInput collects the given resource properties into a map suitable for passing to executeScript.
+func scriptInput(d *schema.ResourceData, keys ...string) map[string]interface{} {
+	ret := map[string]interface{}{}
+	for _, key := range keys {
+		ret[key] = normalizeScriptValue(d.Get(key))
+	}
+	return ret
+}
+
+// normalizeScriptValue converts schema sets, which cannot be serialized, into plain lists.
+func normalizeScriptValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case *schema.Set:
+		return normalizeScriptValue(v.List())
+	case []interface{}:
+		ret := make([]interface{}, len(v))
+		for i, item := range v {
+			ret[i] = normalizeScriptValue(item)
+		}
+		return ret
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			ret[k] = normalizeScriptValue(item)
+		}
+		return ret
+	default:
+		return v
+	}
+}
+
+// setScriptOutput stores each of the properties returned by a script into the resource.
+func setScriptOutput(d *schema.ResourceData, output map[string]interface{}) diag.Diagnostics {
+	for key, val := range output {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(fmt.Errorf("could not set %q: %w", key, err))
+		}
+	}
+	return nil
+}
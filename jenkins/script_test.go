@@ -0,0 +1,155 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGroovyString(t *testing.T) {
+	tests := map[string]string{
+		`simple`:       `'simple'`,
+		`it's`:         `'it\'s'`,
+		`back\slash`:   `'back\\slash'`,
+		"multi\nline":  `'multi\nline'`,
+		`${not.a.var}`: `'${not.a.var}'`,
+	}
+
+	for input, expected := range tests {
+		if actual := groovyString(input); actual != expected {
+			t.Errorf("Expected %s but received %s", expected, actual)
+		}
+	}
+}
+
+func TestExecuteScript(t *testing.T) {
+	type result struct {
+		Value string `json:"value"`
+	}
+
+	tests := []struct {
+		name    string
+		output  string
+		err     error
+		want    result
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			output: "some noise\n{\"result\":{\"value\":\"it's\"}}\n",
+			want:   result{Value: "it's"},
+		},
+		{
+			name:    "script-error",
+			output:  "{\"error\":\"groovy.lang.MissingPropertyException: No such property: foo\"}\n",
+			wantErr: true,
+		},
+		{
+			name:    "request-error",
+			err:     fmt.Errorf("403 Forbidden"),
+			wantErr: true,
+		},
+		{
+			name:    "unparseable",
+			output:  "<html>Something went wrong</html>",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &mockJenkinsClient{
+				mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+					if !strings.Contains(script, `parseText('{"value":"it\'s"}')`) {
+						t.Errorf("Expected input to be embedded in script: %s", script)
+					}
+					return tt.output, tt.err
+				},
+			}
+
+			got := result{}
+			err := executeScript(context.Background(), client, "return input", map[string]string{"value": "it's"}, &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("executeScript() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("executeScript() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteUserScript(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		err     error
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			output: "hello\n",
+			want:   "hello\n",
+		},
+		{
+			name:    "script-error",
+			output:  "hello\n" + userScriptError + "java.lang.IllegalStateException: no executors\n",
+			want:    "hello\n",
+			wantErr: true,
+		},
+		{
+			name:    "request-error",
+			err:     fmt.Errorf("403 Forbidden"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &mockJenkinsClient{
+				mockExecuteScript: func(ctx context.Context, script string) (string, error) {
+					if !strings.Contains(script, `evaluate('println(\'hello\')')`) {
+						t.Errorf("Expected the script to be embedded in the wrapper: %s", script)
+					}
+					return tt.output, tt.err
+				},
+			}
+
+			got, err := executeUserScript(context.Background(), client, "println('hello')")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("executeUserScript() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("executeUserScript() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScriptOutput(t *testing.T) {
+	d := resourceJenkinsScriptApproval().TestResourceData()
+	d.Set("signatures", []interface{}{"method java.lang.String trim"})
+
+	input := scriptInput(d, "signatures", "script_hashes")
+	if !reflect.DeepEqual(input["signatures"], []interface{}{"method java.lang.String trim"}) {
+		t.Errorf("Expected signatures to be converted to a list but received %#v", input["signatures"])
+	}
+
+	diags := setScriptOutput(d, map[string]interface{}{
+		"script_hashes": []interface{}{"SHA512:abcd"},
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error setting script output: %v", diags)
+	}
+
+	if d.Get("script_hashes").(*schema.Set).Len() != 1 {
+		t.Errorf("Expected script_hashes to be populated from the script output")
+	}
+}
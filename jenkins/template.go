@@ -2,8 +2,14 @@ package jenkins
 
 import (
 	"bytes"
+	"encoding/xml"
+	"fmt"
 	"html/template"
+	"io"
 	"log"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,7 +23,14 @@ type job struct {
 	Parameters  map[string]string
 }
 
-func renderTemplate(data string, d *schema.ResourceData) (string, error) {
+// resourceValues is satisfied by both the state of a resource and its planned changes,
+// so that templates can be rendered while planning as well as while applying.
+type resourceValues interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+func renderTemplate(data string, d resourceValues) (string, error) {
 	log.Printf("[DEBUG] jenkins::xml - Binding template:\n%s", data)
 
 	// create and parse the config.xml template
@@ -61,3 +74,107 @@ func renderTemplate(data string, d *schema.ResourceData) (string, error) {
 	log.Printf("[DEBUG] jenkins::xml - Bound template:\n%s", xml)
 	return xml, nil
 }
+
+// jobSecurity is the job-level counterpart of the folder authorization matrix. It is not part of the
+// job template, but is spliced into the rendered config.xml whenever the "security" block is set.
+type jobSecurity struct {
+	XMLName xml.Name `xml:"hudson.security.AuthorizationMatrixProperty"`
+	folderSecurity
+}
+
+var (
+	jobSecurityPattern     = regexp.MustCompile(`(?s)\s*<hudson\.security\.AuthorizationMatrixProperty>.*?</hudson\.security\.AuthorizationMatrixProperty>`)
+	emptyPropertiesPattern = regexp.MustCompile(`<properties>\s*</properties>`)
+)
+
+// bindJobSecurity adds the given authorization matrix to the properties of a rendered job,
+// replacing any matrix that the template may already contain.
+func bindJobSecurity(config string, security *folderSecurity) (string, error) {
+	if security == nil {
+		return config, nil
+	}
+
+	property, err := xml.MarshalIndent(jobSecurity{folderSecurity: *security}, "", "\t")
+	if err != nil {
+		return "", err
+	}
+
+	config = jobSecurityPattern.ReplaceAllLiteralString(config, "")
+	switch {
+	case strings.Contains(config, "<properties/>"):
+		return strings.Replace(config, "<properties/>", "<properties>"+string(property)+"</properties>", 1), nil
+	case strings.Contains(config, "<properties>"):
+		return strings.Replace(config, "<properties>", "<properties>"+string(property), 1), nil
+	}
+	return "", fmt.Errorf("the template has no <properties> element to add security to")
+}
+
+// extractJobSecurity separates the authorization matrix from a job's config.xml, returning the
+// remaining configuration so that it can be compared against the template that produced it.
+func extractJobSecurity(config string) (string, *folderSecurity, error) {
+	match := jobSecurityPattern.FindString(config)
+	if match == "" {
+		return config, nil, nil
+	}
+
+	security := jobSecurity{}
+	if err := xml.Unmarshal([]byte(match), &security); err != nil {
+		return config, nil, fmt.Errorf("could not parse job security: %w", err)
+	}
+
+	config = jobSecurityPattern.ReplaceAllLiteralString(config, "")
+	config = emptyPropertiesPattern.ReplaceAllLiteralString(config, "<properties/>")
+	return config, &security.folderSecurity, nil
+}
+
+// droppedElements lists the elements of a submitted configuration that are missing from the configuration Jenkins
+// applied, as Jenkins silently drops elements it does not know, such as those of plugins that are not installed.
+// Elements are given by their path from the root element, and only the outermost of the dropped elements is listed.
+func droppedElements(submitted, applied string) ([]string, error) {
+	submittedPaths, err := elementPaths(submitted)
+	if err != nil {
+		return nil, err
+	}
+	appliedPaths, err := elementPaths(applied)
+	if err != nil {
+		return nil, err
+	}
+
+	dropped := []string{}
+	for path := range submittedPaths {
+		if appliedPaths[path] {
+			continue
+		}
+		// Elements within a dropped element are dropped along with it
+		if i := strings.LastIndex(path, "/"); i >= 0 && !appliedPaths[path[:i]] {
+			continue
+		}
+		dropped = append(dropped, path)
+	}
+	sort.Strings(dropped)
+	return dropped, nil
+}
+
+// elementPaths lists the paths of all elements within an XML document, such as "project/builders/hudson.tasks.Shell".
+func elementPaths(config string) (map[string]bool, error) {
+	paths := map[string]bool{}
+	stack := []string{}
+
+	decoder := xml.NewDecoder(bytes.NewReader(handleXml(config)))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return paths, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, token.Name.Local)
+			paths[strings.Join(stack, "/")] = true
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package jenkins
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -66,3 +67,71 @@ func TestRenderTemplateInvalid(t *testing.T) {
 		t.Errorf("Expected an error to be emitted with an invalid template: %s", err)
 	}
 }
+
+func TestBindJobSecurity(t *testing.T) {
+	security := &folderSecurity{
+		InheritanceStrategy: folderPermissionInheritanceStrategy{Class: "org.jenkinsci.plugins.matrixauth.inheritance.NonInheritingStrategy"},
+		Permission:          []string{"hudson.model.Item.Build:deployer"},
+	}
+
+	// Without any security the template is left alone
+	input := "<project><properties/></project>"
+	if actual, err := bindJobSecurity(input, nil); err != nil {
+		t.Fatal(err)
+	} else if actual != input {
+		t.Errorf("Expected %s to be left unchanged but received %s", input, actual)
+	}
+
+	// Security is bound into the job properties and read back out of them
+	actual, err := bindJobSecurity(input, security)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, extracted, err := extractJobSecurity(actual)
+	if err != nil {
+		t.Fatal(err)
+	} else if config != input {
+		t.Errorf("Expected %s to be considered equal to %s", config, input)
+	} else if !reflect.DeepEqual(extracted, security) {
+		t.Errorf("Expected %v to be considered equal to %v", extracted, security)
+	}
+
+	// Templates with no properties cannot carry security
+	if _, err := bindJobSecurity("<project/>", security); err == nil {
+		t.Errorf("Expected an error to be emitted for a template without properties")
+	}
+}
+
+func TestDroppedElements(t *testing.T) {
+	submitted := `<project>
+  <description>Builds</description>
+  <builders>
+    <hudson.tasks.Shell><command>make</command></hudson.tasks.Shell>
+    <org.example.MissingBuilder><target>all</target></org.example.MissingBuilder>
+  </builders>
+  <org.example.MissingProperty/>
+</project>`
+	applied := `<?xml version='1.1' encoding='UTF-8'?>
+<project>
+  <description>Builds</description>
+  <keepDependencies>false</keepDependencies>
+  <builders>
+    <hudson.tasks.Shell><command>make</command></hudson.tasks.Shell>
+  </builders>
+</project>`
+
+	expected := []string{"project/builders/org.example.MissingBuilder", "project/org.example.MissingProperty"}
+	if actual, err := droppedElements(submitted, applied); err != nil || !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v to be dropped, but got %v: %v", expected, actual, err)
+	}
+
+	if actual, err := droppedElements(submitted, submitted); err != nil || len(actual) != 0 {
+		t.Errorf("Expected nothing to be dropped, but got %v: %v", actual, err)
+	}
+
+	// Test if we fail when we should
+	if _, err := droppedElements("<project>", applied); err == nil {
+		t.Error("Expected malformed configurations to be rejected")
+	}
+}
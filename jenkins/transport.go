@@ -0,0 +1,497 @@
+package jenkins
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// maxLoggedBody is the amount of a request or response body that is logged, as error pages are often full HTML pages.
+const maxLoggedBody = 4096
+
+// sensitiveFieldNames matches the names of fields that are likely to be secret, whether or not they are known to the provider.
+const sensitiveFieldNames = `[a-z_-]*(password|passphrase|secret|token|crumb|private_?key)[a-z_-]*`
+
+// loggingTransport logs each request made to Jenkins for debugging with TF_LOG, along with the request and
+// response bodies of any that fail. Known secrets, and the values of fields that are or appear to be
+// sensitive, are redacted from the output.
+type loggingTransport struct {
+	secrets []string
+	fields  *regexp.Regexp
+	next    http.RoundTripper
+}
+
+func newLoggingTransport(secrets []string, sensitiveFields []string, next http.RoundTripper) *loggingTransport {
+	names := []string{sensitiveFieldNames}
+	for _, field := range sensitiveFields {
+		names = append(names, regexp.QuoteMeta(field))
+	}
+
+	// Matches both "field":"value" and field=value pairs, keeping the field name in the first group
+	pattern := `(?i)("?(?:` + strings.Join(names, "|") + `)"?\s*[:=]\s*)("(?:\\.|[^"\\])*"|[^\s,&}]+)`
+	return &loggingTransport{secrets: secrets, fields: regexp.MustCompile(pattern), next: next}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start).Round(time.Millisecond)
+
+	if err != nil {
+		structuredLog.Debug("jenkins::http - Request failed", "method", req.Method, "path", req.URL.Path, "duration_ms", duration.Milliseconds(), "error", t.redact(err.Error()))
+		t.logRequestBody(req)
+		return resp, err
+	}
+
+	structuredLog.Debug("jenkins::http - Request completed", "method", req.Method, "path", req.URL.Path, "status", resp.StatusCode, "duration_ms", duration.Milliseconds())
+	if resp.StatusCode >= 400 {
+		t.logRequestBody(req)
+
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxLoggedBody))
+		log.Printf("[DEBUG] jenkins::http - %s %s response body: %s", req.Method, req.URL.Path, t.redact(string(body)))
+
+		// Hand the complete body on to the caller, regardless of how much of it was logged
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	}
+
+	return resp, nil
+}
+
+// logRequestBody logs a copy of the body that was sent with a failed request, if it can be recovered.
+func (t *loggingTransport) logRequestBody(req *http.Request) {
+	if req.GetBody == nil {
+		return
+	}
+	reader, err := req.GetBody()
+	if err != nil {
+		return
+	}
+	defer reader.Close()
+
+	body, _ := ioutil.ReadAll(io.LimitReader(reader, maxLoggedBody))
+	text := string(body)
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		// Decode forms, such as those carrying scripts, so that the fields within them can be redacted
+		if values, err := url.ParseQuery(text); err == nil {
+			fields := []string{}
+			for key, vals := range values {
+				for _, val := range vals {
+					fields = append(fields, key+"="+val)
+				}
+			}
+			text = strings.Join(fields, "\n")
+		}
+	}
+
+	log.Printf("[DEBUG] jenkins::http - %s %s request body: %s", req.Method, req.URL.Path, t.redact(text))
+}
+
+// redact removes the known secrets, and the values of any fields that are or appear to be sensitive, from the given text.
+func (t *loggingTransport) redact(text string) string {
+	for _, secret := range t.secrets {
+		if secret != "" {
+			text = strings.ReplaceAll(text, secret, "[REDACTED]")
+		}
+	}
+	return t.fields.ReplaceAllString(text, "${1}[REDACTED]")
+}
+
+// headerTransport adds a fixed set of headers, such as those expected by an authenticating proxy, to every request.
+type headerTransport struct {
+	headers http.Header
+	next    http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the request they were given
+	req = req.Clone(req.Context())
+	for key, values := range t.headers {
+		req.Header[key] = values
+	}
+	return t.next.RoundTrip(req)
+}
+
+// limitTransport caps the number of requests in flight at once, and the rate at which requests are sent,
+// so that large applies do not overwhelm small controllers.
+type limitTransport struct {
+	slots    chan struct{} // nil for no concurrency limit
+	interval time.Duration // zero for no rate limit
+
+	mu   sync.Mutex
+	next time.Time
+	rt   http.RoundTripper
+}
+
+func newLimitTransport(maxConcurrent int, perSecond float64, next http.RoundTripper) *limitTransport {
+	t := &limitTransport{rt: next}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	if perSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			defer func() { <-t.slots }()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if wait := t.reserve(); wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	return t.rt.RoundTrip(req)
+}
+
+// reserve claims the next available time to send a request at, returning how long to wait until then.
+func (t *limitTransport) reserve() time.Duration {
+	if t.interval == 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+	return wait
+}
+
+// crumbTransport intercepts the requests made for CSRF crumbs, either disabling them entirely for controllers
+// that do not require them, or reusing the first crumb (and the session it is bound to) for all later requests.
+type crumbTransport struct {
+	disabled bool
+	cache    bool
+
+	mu     sync.Mutex
+	cached *crumbResponse
+	next   http.RoundTripper
+}
+
+type crumbResponse struct {
+	header http.Header
+	body   []byte
+}
+
+func (t *crumbTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.Contains(req.URL.Path, "/crumbIssuer/api/") {
+		resp, err := t.next.RoundTrip(req)
+		if err == nil && resp.StatusCode == http.StatusForbidden {
+			// The crumb may have expired along with its session, so fetch a fresh one next time
+			t.mu.Lock()
+			t.cached = nil
+			t.mu.Unlock()
+		}
+		return resp, err
+	}
+
+	if t.disabled {
+		// Without a crumb issuer no crumb will be sent, just as with controllers that have CSRF protection disabled
+		return &http.Response{
+			Status:     "404 Not Found",
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{},
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+
+	if !t.cache {
+		return t.next.RoundTrip(req)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.cached == nil {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusOK {
+			return resp, err
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		t.cached = &crumbResponse{header: resp.Header, body: body}
+	} else {
+		log.Printf("[DEBUG] jenkins::http - Reusing cached crumb")
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Header:        t.cached.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(t.cached.body)),
+		ContentLength: int64(len(t.cached.body)),
+		Request:       req,
+	}, nil
+}
+
+// sessionTransport reuses the session established by the first authenticated request, sending later requests
+// with only the session cookie so that Jenkins does not need to authenticate each of them again. Requests are
+// sent with their credentials again should the session be rejected.
+type sessionTransport struct {
+	jar http.CookieJar
+
+	mu          sync.Mutex
+	unsupported bool
+	next        http.RoundTripper
+}
+
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	if req.Header.Get("Authorization") == "" || !replayable || !t.hasSession(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	anonymous := req.Clone(req.Context())
+	anonymous.Header.Del("Authorization")
+	resp, err := t.next.RoundTrip(anonymous)
+	if err != nil || (resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusForbidden) {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	log.Printf("[DEBUG] jenkins::http - Session rejected for %s %s, authenticating again", req.Method, req.URL.Path)
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err = t.next.RoundTrip(retry)
+	if err == nil && resp.StatusCode < 400 && resp.Header.Get("Set-Cookie") == "" {
+		// The credentials were accepted without a new session being started, so sessions are not retained
+		log.Printf("[DEBUG] jenkins::http - Jenkins is not retaining sessions, authenticating every request")
+		t.mu.Lock()
+		t.unsupported = true
+		t.mu.Unlock()
+	}
+	return resp, err
+}
+
+// hasSession reports whether a Jenkins session has been established that the request could be sent with.
+func (t *sessionTransport) hasSession(req *http.Request) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.unsupported {
+		return false
+	}
+
+	for _, cookie := range t.jar.Cookies(req.URL) {
+		if cookie.Name == "JSESSIONID" || strings.HasPrefix(cookie.Name, "JSESSIONID.") {
+			return true
+		}
+	}
+	return false
+}
+
+// retryTransport retries idempotent requests that failed for reasons that are likely to be temporary,
+// such as an overloaded or restarting controller, with an exponentially increasing delay.
+//
+// While the controller is restarting, such as after installing plugins, every request is retried until
+// the controller is back or the request's deadline passes, as Jenkins will not have acted on it.
+type retryTransport struct {
+	maxRetries int
+	minDelay   time.Duration
+	maxDelay   time.Duration
+	next       http.RoundTripper
+}
+
+// The delays between retries used when the provider configures none.
+const (
+	defaultRetryMinDelay = time.Second
+	defaultRetryMaxDelay = 30 * time.Second
+)
+
+// restartRetryLimit bounds how long requests without a deadline of their own wait for a restarting controller.
+const restartRetryLimit = 5 * time.Minute
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	deadline, ok := req.Context().Deadline()
+	if !ok {
+		deadline = time.Now().Add(restartRetryLimit)
+	}
+
+	policy := retryPolicyFrom(req.Context())
+	retries, resourceRetries := 0, 0
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+
+		var delay time.Duration
+		switch {
+		case policy.matches(resp, err) && resourceRetries < policy.attempts && isReplayable(req):
+			// Resources may ask for any request to be retried, as they know which of their failures are temporary
+			delay = policy.backoff << uint(resourceRetries)
+			if delay > t.maxDelay || delay <= 0 {
+				delay = t.maxDelay
+			}
+			resourceRetries++
+		case isRestarting(req, resp, err) && isReplayable(req):
+			delay = t.backoff(attempt, resp)
+			if time.Now().Add(delay).After(deadline) {
+				return resp, err
+			}
+		case isIdempotent(req) && retries < t.maxRetries && isRetryable(resp, err):
+			delay = t.backoff(attempt, resp)
+			retries++
+		default:
+			return resp, err
+		}
+
+		if err != nil {
+			log.Printf("[DEBUG] jenkins::http - %s %s failed, retrying in %s: %v", req.Method, req.URL.Path, delay, err)
+		} else {
+			log.Printf("[DEBUG] jenkins::http - %s %s returned %s, retrying in %s", req.Method, req.URL.Path, resp.Status, delay)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+
+		if req, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryPolicy is the retry configuration of a single resource, which applies to every request made for it
+// on top of the retries of the provider itself.
+type retryPolicy struct {
+	attempts int
+	onStatus map[int]bool
+	backoff  time.Duration
+}
+
+type retryPolicyKey struct{}
+
+// withRetryPolicy attaches the retry configuration of a resource to the context of its operations.
+func withRetryPolicy(ctx context.Context, policy *retryPolicy) context.Context {
+	if policy == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+func retryPolicyFrom(ctx context.Context) *retryPolicy {
+	policy, _ := ctx.Value(retryPolicyKey{}).(*retryPolicy)
+	return policy
+}
+
+// matches reports whether a response has one of the statuses that the resource retries on.
+func (p *retryPolicy) matches(resp *http.Response, err error) bool {
+	return p != nil && err == nil && p.onStatus[resp.StatusCode]
+}
+
+// backoff calculates the delay before the given retry, honoring any delay requested by the controller.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	delay := t.minDelay << uint(attempt)
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			delay = time.Duration(seconds) * time.Second
+		}
+	}
+
+	if delay > t.maxDelay || delay <= 0 {
+		delay = t.maxDelay
+	}
+	return delay
+}
+
+// isIdempotent reports whether a request may be safely sent more than once. Requests with a body
+// are never retried, as the body will already have been consumed.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return req.Body == nil || req.Body == http.NoBody
+	default:
+		return false
+	}
+}
+
+// isReplayable reports whether a request can be sent again, which requires its body to be recreated.
+func isReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindRequest prepares a request to be sent again, with a fresh copy of its body.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	ret := req.Clone(req.Context())
+	ret.Body = body
+	return ret, nil
+}
+
+// isRestarting reports whether a request failed because the controller is restarting. Jenkins responds with a 503
+// while starting up, and refused connections never reached it, so neither will have been acted on. Proxies in front
+// of Jenkins respond with a 502 instead, but also when Jenkins fails after acting on a request, so those are only
+// waited out for requests that can safely be sent twice.
+func isRestarting(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNREFUSED)
+	}
+
+	return resp.StatusCode == http.StatusServiceUnavailable || (resp.StatusCode == http.StatusBadGateway && isIdempotent(req))
+}
+
+// isRetryable reports whether a failed request could succeed if it were sent again.
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented)
+}
+
+// contextTransport binds requests to a context. gojenkins creates its requests without the context it is given,
+// so the clients handed to it are wrapped in this to still be cancelled along with the operation that made them.
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
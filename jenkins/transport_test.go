@@ -0,0 +1,404 @@
+package jenkins
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHeaderTransport(t *testing.T) {
+	received := http.Header{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &headerTransport{
+		headers: http.Header{
+			"Authorization":    []string{"Bearer token"},
+			"X-Forwarded-User": []string{"terraform"},
+		},
+		next: http.DefaultTransport,
+	}}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.SetBasicAuth("admin", "password")
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if received.Get("Authorization") != "Bearer token" {
+		t.Errorf("Expected the configured Authorization header to take precedence, received %q", received.Get("Authorization"))
+	}
+	if received.Get("X-Forwarded-User") != "terraform" {
+		t.Errorf("Expected the custom header to be sent, received %q", received.Get("X-Forwarded-User"))
+	}
+	if req.Header.Get("X-Forwarded-User") != "" {
+		t.Errorf("Expected the original request to be left unmodified")
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		maxRetries: 3,
+		minDelay:   time.Millisecond,
+		maxDelay:   10 * time.Millisecond,
+		next:       http.DefaultTransport,
+	}}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || attempts != 3 {
+		t.Errorf("Expected success after 3 attempts, received %d after %d attempts", resp.StatusCode, attempts)
+	}
+
+	// Non-idempotent requests must never be replayed after an error Jenkins may have acted upon
+	attempts = 0
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	resp, err = client.Post(failing.URL, "text/plain", strings.NewReader("body"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusInternalServerError || attempts != 1 {
+		t.Errorf("Expected a single failed attempt, received %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestRetryTransport_resourcePolicy(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			// Such as creating a job within a folder that Jenkins has not finished creating yet
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		maxRetries: 0,
+		minDelay:   time.Millisecond,
+		maxDelay:   10 * time.Millisecond,
+		next:       http.DefaultTransport,
+	}}
+
+	// Resources may retry requests on statuses that the provider would not retry on its own
+	ctx := withRetryPolicy(context.Background(), &retryPolicy{attempts: 3, onStatus: map[int]bool{http.StatusBadRequest: true}, backoff: time.Millisecond})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader("body"))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || attempts != 3 {
+		t.Errorf("Expected success after 3 attempts, received %d after %d attempts", resp.StatusCode, attempts)
+	}
+
+	// Retries end once the attempts of the resource are used up
+	attempts = 0
+	ctx = withRetryPolicy(context.Background(), &retryPolicy{attempts: 1, onStatus: map[int]bool{http.StatusBadRequest: true}, backoff: time.Millisecond})
+	req, _ = http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader("body"))
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadRequest || attempts != 2 {
+		t.Errorf("Expected failure after 2 attempts, received %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestRetryTransport_restarting(t *testing.T) {
+	attempts := 0
+	bodies := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if attempts < 6 {
+			// More attempts than max_retries allows, as waiting for a restart is bounded by time instead
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		maxRetries: 1,
+		minDelay:   time.Millisecond,
+		maxDelay:   10 * time.Millisecond,
+		next:       http.DefaultTransport,
+	}}
+
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("body"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || attempts != 6 {
+		t.Errorf("Expected success after 6 attempts, received %d after %d attempts", resp.StatusCode, attempts)
+	}
+	for _, body := range bodies {
+		if body != "body" {
+			t.Errorf("Expected the body to be sent with every attempt, received %q", body)
+		}
+	}
+
+	// Requests that are not idempotent are not sent again on a 502, as Jenkins may have acted on them already
+	attempts = 0
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	resp, err = client.Post(server.URL, "text/plain", strings.NewReader("body"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadGateway || attempts != 1 {
+		t.Errorf("Expected the 502 to be reported without a retry, received %d after %d attempts", resp.StatusCode, attempts)
+	}
+
+	// Idempotent requests are waited out on a 502 until the request's deadline would pass
+	attempts = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadGateway || attempts < 2 {
+		t.Errorf("Expected the restart to be reported once the deadline had passed, received %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	rt := &retryTransport{minDelay: time.Second, maxDelay: 5 * time.Second}
+
+	if actual := rt.backoff(1, nil); actual != 2*time.Second {
+		t.Errorf("Expected the delay to double, received %s", actual)
+	}
+	if actual := rt.backoff(10, nil); actual != 5*time.Second {
+		t.Errorf("Expected the delay to be capped, received %s", actual)
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if actual := rt.backoff(0, resp); actual != 3*time.Second {
+		t.Errorf("Expected the Retry-After header to be honored, received %s", actual)
+	}
+}
+
+func TestLimitTransport(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newLimitTransport(2, 0, http.DefaultTransport)}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if resp, err := client.Get(server.URL); err == nil {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, received %d", peak)
+	}
+}
+
+func TestLimitTransport_reserve(t *testing.T) {
+	rt := newLimitTransport(0, 10, http.DefaultTransport)
+
+	if wait := rt.reserve(); wait != 0 {
+		t.Errorf("Expected the first request to be sent immediately, waited %s", wait)
+	}
+	if wait := rt.reserve(); wait <= 0 || wait > 100*time.Millisecond {
+		t.Errorf("Expected the second request to wait up to 100ms, waited %s", wait)
+	}
+}
+
+func TestCrumbTransport(t *testing.T) {
+	crumbs := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "/crumbIssuer/api/"):
+			crumbs++
+			http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "session"})
+			_, _ = w.Write([]byte(`{"crumbRequestField":"Jenkins-Crumb","crumb":"abc"}`))
+		case r.Header.Get("Jenkins-Crumb") != "abc":
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &crumbTransport{cache: true, next: http.DefaultTransport}}
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL + "/crumbIssuer/api/json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.Header.Get("Set-Cookie") == "" {
+			t.Errorf("Expected the session cookie to be replayed")
+		}
+	}
+	if crumbs != 1 {
+		t.Errorf("Expected a single crumb to be fetched, received %d", crumbs)
+	}
+
+	// Rejected requests should invalidate the cached crumb
+	resp, err := client.Post(server.URL+"/scriptText", "text/plain", strings.NewReader("body"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	resp, err = client.Get(server.URL + "/crumbIssuer/api/json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if crumbs != 2 {
+		t.Errorf("Expected a new crumb to be fetched after a rejection, received %d", crumbs)
+	}
+
+	// Disabled crumbs never reach the controller
+	client = &http.Client{Transport: &crumbTransport{disabled: true, next: http.DefaultTransport}}
+	resp, err = client.Get(server.URL + "/crumbIssuer/api/json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound || crumbs != 2 {
+		t.Errorf("Expected the crumb issuer to be skipped, received %d after %d crumbs", resp.StatusCode, crumbs)
+	}
+}
+
+func TestLoggingTransport_redact(t *testing.T) {
+	rt := newLoggingTransport([]string{"hunter2"}, []string{"manager_dn"}, http.DefaultTransport)
+
+	tests := map[string]string{
+		`{"username":"admin","password":"s3cr\"et"}`: `{"username":"admin","password":[REDACTED]}`,
+		`Jenkins-Crumb=abc&json=1`:                   `Jenkins-Crumb=[REDACTED]&json=1`,
+		`{"client_secret": "abc", "name": "x"}`:      `{"client_secret": [REDACTED], "name": "x"}`,
+		`{"manager_dn":"cn=admin"}`:                  `{"manager_dn":[REDACTED]}`,
+		`authentication failed for hunter2`:          `authentication failed for [REDACTED]`,
+	}
+	for input, expected := range tests {
+		if actual := rt.redact(input); actual != expected {
+			t.Errorf("Expected %s to be redacted to %s but received %s", input, expected, actual)
+		}
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid request"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newLoggingTransport(nil, nil, http.DefaultTransport)}
+	resp, err := client.Post(server.URL, "application/x-www-form-urlencoded", strings.NewReader("script=println+1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	// The logged body must still reach the caller
+	body, _ := ioutil.ReadAll(resp.Body)
+	if string(body) != "invalid request" {
+		t.Errorf("Expected the response body to be passed on, received %q", body)
+	}
+}
+
+func TestSessionTransport(t *testing.T) {
+	authenticated := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); ok {
+			authenticated++
+			http.SetCookie(w, &http.Cookie{Name: "JSESSIONID.abc", Value: "session"})
+			return
+		}
+		if cookie, err := r.Cookie("JSESSIONID.abc"); err != nil || cookie.Value != "session" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{Jar: jar, Transport: &sessionTransport{jar: jar, next: http.DefaultTransport}}
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		req.SetBasicAuth("admin", "password")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Expected the request to succeed, received %d", resp.StatusCode)
+		}
+	}
+	if authenticated != 1 {
+		t.Errorf("Expected a single authenticated request, received %d", authenticated)
+	}
+
+	// An expired session falls back to the credentials
+	jar.SetCookies(mustParseURL(t, server.URL), []*http.Cookie{{Name: "JSESSIONID.abc", Value: "expired"}})
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.SetBasicAuth("admin", "password")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || authenticated != 2 {
+		t.Errorf("Expected the request to be authenticated again, received %d after %d authentications", resp.StatusCode, authenticated)
+	}
+}
+
+func mustParseURL(t *testing.T, raw string) *url.URL {
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
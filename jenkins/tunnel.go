@@ -0,0 +1,128 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// sshTunnel dials connections to Jenkins through a jump host, for controllers that are only reachable from a
+// bastion. The SSH connection is established on first use and re-established should it be lost.
+type sshTunnel struct {
+	host   string
+	config *ssh.ClientConfig
+
+	mu     sync.Mutex
+	client *ssh.Client
+}
+
+func newSSHTunnel(host, user string, privateKey []byte, hostKeyCallback ssh.HostKeyCallback) (*sshTunnel, error) {
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse SSH private key: %w", err)
+	}
+
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "22")
+	}
+
+	return &sshTunnel{
+		host: host,
+		config: &ssh.ClientConfig{
+			User:            user,
+			Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         30 * time.Second,
+		},
+	}, nil
+}
+
+// sshHostKeyCallback verifies the identity of the jump host against the given host key or, if none is given,
+// against the known_hosts file. Any host is only trusted when that has been asked for explicitly.
+func sshHostKeyCallback(hostKey, knownHostsFile string, insecure bool) (ssh.HostKeyCallback, error) {
+	if insecure {
+		if hostKey != "" {
+			return nil, fmt.Errorf("host_key and insecure_ignore_host_key cannot both be set")
+		}
+		log.Printf("[WARN] jenkins::tunnel - Host key verification is disabled, the identity of the jump host will not be verified")
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	if hostKey != "" {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse SSH host key: %w", err)
+		}
+		return ssh.FixedHostKey(key), nil
+	}
+
+	callback, err := knownhosts.New(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("no SSH host key given and the known hosts could not be read, set host_key or insecure_ignore_host_key: %w", err)
+	}
+	return callback, nil
+}
+
+// defaultKnownHostsFile returns the known_hosts file of the user running Terraform.
+func defaultKnownHostsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".ssh", "known_hosts")
+}
+
+// DialContext opens a connection to the given address from the jump host.
+func (t *sshTunnel) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	client, err := t.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := client.Dial(network, addr)
+	if err != nil {
+		// The SSH connection may have been lost, so try again once over a new one
+		log.Printf("[DEBUG] jenkins::tunnel - Could not dial %s through %s, reconnecting: %v", addr, t.host, err)
+		t.reset(client)
+		if client, err = t.connect(); err != nil {
+			return nil, err
+		}
+		return client.Dial(network, addr)
+	}
+	return conn, nil
+}
+
+// connect returns the SSH connection to the jump host, establishing it if needed.
+func (t *sshTunnel) connect() (*ssh.Client, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.client == nil {
+		client, err := ssh.Dial("tcp", t.host, t.config)
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to SSH jump host %s: %w", t.host, err)
+		}
+		log.Printf("[DEBUG] jenkins::tunnel - Connected to %s", t.host)
+		t.client = client
+	}
+	return t.client, nil
+}
+
+// reset discards the given SSH connection, unless it has already been replaced.
+func (t *sshTunnel) reset(client *ssh.Client) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.client == client {
+		t.client.Close()
+		t.client = nil
+	}
+}
@@ -0,0 +1,174 @@
+package jenkins
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// startSSHServer runs a minimal SSH server that only supports port forwarding, returning its address and host key.
+func startSSHServer(t *testing.T, authorized ssh.PublicKey) (string, ssh.PublicKey) {
+	_, hostPrivate, _ := ed25519.GenerateKey(rand.Reader)
+	hostSigner, _ := ssh.NewSignerFromKey(hostPrivate)
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if string(key.Marshal()) != string(authorized.Marshal()) {
+				return nil, io.EOF
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, channels, requests, err := ssh.NewServerConn(conn, config)
+				if err != nil {
+					return
+				}
+				go ssh.DiscardRequests(requests)
+				for newChannel := range channels {
+					target := struct {
+						Host       string
+						Port       uint32
+						OriginHost string
+						OriginPort uint32
+					}{}
+					if err := ssh.Unmarshal(newChannel.ExtraData(), &target); err != nil {
+						_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+						continue
+					}
+					upstream, err := net.Dial("tcp", net.JoinHostPort(target.Host, fmt.Sprint(target.Port)))
+					if err != nil {
+						_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+						continue
+					}
+					channel, requests, _ := newChannel.Accept()
+					go ssh.DiscardRequests(requests)
+					go func() { _, _ = io.Copy(channel, upstream); channel.Close() }()
+					go func() { _, _ = io.Copy(upstream, channel); upstream.Close() }()
+				}
+			}()
+		}
+	}()
+
+	return listener.Addr().String(), hostSigner.PublicKey()
+}
+
+func TestSSHTunnel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("tunneled"))
+	}))
+	defer server.Close()
+
+	clientPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorized, _ := ssh.NewPublicKey(&clientPrivate.PublicKey)
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(clientPrivate)}
+
+	addr, hostKey := startSSHServer(t, authorized)
+	tunnel, err := newSSHTunnel(addr, "terraform", pem.EncodeToMemory(block), ssh.FixedHostKey(hostKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := newHTTPClient(&Config{SSHTunnel: tunnel})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if string(body) != "tunneled" {
+		t.Errorf("Expected the request to be tunneled, received %q", body)
+	}
+}
+
+func TestNewSSHTunnel(t *testing.T) {
+	if _, err := newSSHTunnel("bastion", "terraform", []byte("not a key"), ssh.InsecureIgnoreHostKey()); err == nil {
+		t.Errorf("Expected an invalid private key to be rejected")
+	}
+}
+
+func TestSSHHostKeyCallback(t *testing.T) {
+	public, _, _ := ed25519.GenerateKey(rand.Reader)
+	hostKey, _ := ssh.NewPublicKey(public)
+	otherPublic, _, _ := ed25519.GenerateKey(rand.Reader)
+	otherKey, _ := ssh.NewPublicKey(otherPublic)
+	addr := &net.TCPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 22}
+
+	dir, err := ioutil.TempDir("", "known_hosts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	knownHosts := filepath.Join(dir, "known_hosts")
+	if err := ioutil.WriteFile(knownHosts, []byte(knownhosts.Line([]string{"bastion:22"}, hostKey)+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		hostKey        string
+		knownHostsFile string
+		insecure       bool
+		presented      ssh.PublicKey
+		wantErr        bool
+		wantRejected   bool
+	}{
+		{name: "host key", hostKey: string(ssh.MarshalAuthorizedKey(hostKey)), presented: hostKey},
+		{name: "other host key", hostKey: string(ssh.MarshalAuthorizedKey(hostKey)), presented: otherKey, wantRejected: true},
+		{name: "invalid host key", hostKey: "not a key", wantErr: true},
+		{name: "known hosts", knownHostsFile: knownHosts, presented: hostKey},
+		{name: "other known host", knownHostsFile: knownHosts, presented: otherKey, wantRejected: true},
+		{name: "no known hosts", knownHostsFile: filepath.Join(dir, "missing"), wantErr: true},
+		{name: "insecure", knownHostsFile: filepath.Join(dir, "missing"), insecure: true, presented: otherKey},
+		{name: "insecure with host key", hostKey: string(ssh.MarshalAuthorizedKey(hostKey)), insecure: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callback, err := sshHostKeyCallback(tt.hostKey, tt.knownHostsFile, tt.insecure)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sshHostKeyCallback() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if err := callback("bastion:22", addr, tt.presented); (err != nil) != tt.wantRejected {
+				t.Errorf("Expected the host key to be rejected: %v, received %v", tt.wantRejected, err)
+			}
+		})
+	}
+}
@@ -2,10 +2,23 @@ package jenkins
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -60,20 +73,219 @@ func parseCanonicalJobID(id string) (name string, folders []string) {
 	return folders[len(folders)-1], folders[0 : len(folders)-1]
 }
 
+// resolveFolder applies the provider's default folder, if any, to the given folder. Folders starting with
+// a "/", such as the IDs of jenkins_folder resources, are absolute and never have the default applied.
+func resolveFolder(meta interface{}, folder string) string {
+	client, ok := meta.(interface{ DefaultFolder() string })
+	if !ok || client.DefaultFolder() == "" || strings.HasPrefix(folder, "/") {
+		return folder
+	}
+
+	if folder == "" {
+		return client.DefaultFolder()
+	}
+	return client.DefaultFolder() + "/" + folder
+}
+
+// flattenFolder determines the value of the "folder" property for a resource found in the given folders.
+// The configured value is kept as long as it still resolves to the same folders, so that relative folders
+// do not show a difference against the absolute path.
+func flattenFolder(meta interface{}, current string, folders []string) string {
+	if strings.Join(extractFolders(resolveFolder(meta, current)), "/") == strings.Join(folders, "/") {
+		return current
+	}
+	return formatFolderID(folders)
+}
+
+// resolveCredentialsFolder applies the provider's default folder to credentials, unless they have the SYSTEM scope.
+// Such credentials are only kept out of reach of jobs within the system store, which the default folder would move them out of.
+func resolveCredentialsFolder(meta interface{}, folder string, scope string) string {
+	if scope == "SYSTEM" {
+		return folder
+	}
+	return resolveFolder(meta, folder)
+}
+
+// parseImportID splits the ID given to an import into the folders that the item is in and the given number of
+// trailing segments that identify the item within them. IDs may use plain paths ("team/builds/name"), the canonical
+// form used throughout this provider ("/job/team/job/builds/name") or the full URL of the item in Jenkins, including
+// the URLs of credentials within a folder's store. Each segment is URL-decoded.
+func parseImportID(id string, count int) (folders []string, segments []string, err error) {
+	path := id
+	if u, err := url.Parse(id); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		// Drop the host and any context path that Jenkins is served under, which come before the first item or store
+		path = ""
+		all := strings.Split(u.EscapedPath(), "/")
+		for i, segment := range all {
+			if segment == "job" || segment == "credentials" {
+				path = strings.Join(all[i:], "/")
+				break
+			}
+		}
+	}
+
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not decode %q: %w", segment, err)
+		}
+		segments = append(segments, decoded)
+	}
+
+	// Credentials are located within a store as ".../credentials/store/<store>/domain/<domain>/credential/<name>"
+	for i := range segments {
+		if len(segments) == i+7 && segments[i] == "credentials" && segments[i+1] == "store" && segments[i+3] == "domain" && segments[i+5] == "credential" {
+			segments = append(segments[:i:i], segments[i+4], segments[i+6])
+			break
+		}
+	}
+
+	if len(segments) < count {
+		return nil, nil, fmt.Errorf("expected at least %d segments but found %d", count, len(segments))
+	}
+	return splitFolderPath(segments[:len(segments)-count]), segments[len(segments)-count:], nil
+}
+
+// splitFolderPath extracts the folder names from a path. Paths where every other segment is a "job" separator are
+// read in the canonical form, so that folders which are themselves named "job" remain unambiguous there.
+func splitFolderPath(segments []string) []string {
+	for _, offset := range []int{0, 1} {
+		if len(segments) <= offset {
+			continue
+		}
+
+		folders, canonical := []string{}, true
+		for i, segment := range segments {
+			if i%2 != offset {
+				folders = append(folders, segment)
+			} else if segment != "job" {
+				canonical = false
+				break
+			}
+		}
+		if canonical {
+			return folders
+		}
+	}
+	return segments
+}
+
+// importFolder determines the value of the "folder" property for an item imported from the given folders.
+// Folders within the provider's default folder are kept relative to it, and all others are made absolute.
+func importFolder(meta interface{}, folders []string) string {
+	client, ok := meta.(interface{ DefaultFolder() string })
+	if !ok || client.DefaultFolder() == "" {
+		return formatFolderName(strings.Join(folders, "/"))
+	}
+
+	defaults := extractFolders(client.DefaultFolder())
+	if len(folders) >= len(defaults) && strings.Join(folders[:len(defaults)], "/") == strings.Join(defaults, "/") {
+		return formatFolderName(strings.Join(folders[len(defaults):], "/"))
+	}
+	return formatFolderID(folders)
+}
+
+// resolveCredentialsDomain applies the provider's default credentials domain to credentials that do not specify one.
+func resolveCredentialsDomain(meta interface{}, domain string) string {
+	if domain != "" {
+		return domain
+	}
+
+	if client, ok := meta.(interface{ DefaultCredentialsDomain() string }); ok && client.DefaultCredentialsDomain() != "" {
+		return client.DefaultCredentialsDomain()
+	}
+	return "_"
+}
+
 // folderExists will validate that a given folder name exists
 func folderExists(ctx context.Context, client jenkinsClient, name string) error {
 	folders := extractFolders(name)
 	if len(folders) > 0 {
+		cache := folderCacheOf(client)
+		if cache.exists(name) {
+			return nil
+		}
+
 		folderName, parentFolders := parseCanonicalJobID(name)
 		_, err := client.GetFolder(ctx, folderName, parentFolders...)
 		if err != nil {
 			return err
 		}
+		cache.add(name)
 	}
 
 	return nil
 }
 
+// folderCacheTTL bounds how long a folder is assumed to still exist, in case it is deleted outside of this provider.
+const folderCacheTTL = 5 * time.Minute
+
+// folderCache remembers the folders recently found to exist, so that creating many resources within the same folder
+// does not look the folder up again for each of them. Folders that could not be found are never cached.
+// All methods are safe to call on a nil cache, which caches nothing.
+type folderCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	expires map[string]time.Time
+}
+
+func newFolderCache(ttl time.Duration) *folderCache {
+	return &folderCache{ttl: ttl, expires: map[string]time.Time{}}
+}
+
+// folderCacheOf returns the folder cache of the provider's client, if it has one.
+func folderCacheOf(client interface{}) *folderCache {
+	if c, ok := client.(interface{ FolderCache() *folderCache }); ok {
+		return c.FolderCache()
+	}
+	return nil
+}
+
+func (c *folderCache) exists(name string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := strings.Join(extractFolders(name), "/")
+	expires, ok := c.expires[key]
+	if ok && time.Now().After(expires) {
+		delete(c.expires, key)
+		return false
+	}
+	return ok
+}
+
+func (c *folderCache) add(name string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expires[strings.Join(extractFolders(name), "/")] = time.Now().Add(c.ttl)
+}
+
+// remove forgets the given folder along with all folders nested within it.
+func (c *folderCache) remove(name string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := strings.Join(extractFolders(name), "/")
+	for cached := range c.expires {
+		if cached == key || strings.HasPrefix(cached, key+"/") {
+			delete(c.expires, cached)
+		}
+	}
+}
+
 func templateDiff(k, old, new string, d *schema.ResourceData) bool {
 	new, _ = renderTemplate(new, d)
 
@@ -90,6 +302,399 @@ func templateDiff(k, old, new string, d *schema.ResourceData) bool {
 	return old == new
 }
 
+// readPEM accepts either PEM-encoded data or the path to a file containing it, returning the PEM data.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN ") {
+		return []byte(value), nil
+	}
+	return ioutil.ReadFile(value)
+}
+
+// normalizeServerURL tidies the path of the server URL so that endpoints can be appended to it directly,
+// as Jenkins may be served under a context path such as "https://host/ci/jenkins".
+func normalizeServerURL(serverURL string) string {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return strings.TrimRight(serverURL, "/")
+	}
+
+	segments := []string{}
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	u.Path = ""
+	if len(segments) > 0 {
+		u.Path = "/" + strings.Join(segments, "/")
+	}
+	u.RawPath = ""
+	return u.String()
+}
+
+// compareVersions compares two Jenkins or plugin version numbers, returning -1, 0 or 1 if a is older than,
+// the same as or newer than b. Only the leading numeric components are compared, so that suffixes such as
+// the commit identifiers of incrementally versioned plugins ("1319.v7eb_51b_3a_c97b_") are ignored.
+func compareVersions(a, b string) int {
+	left, right := versionComponents(a), versionComponents(b)
+	for i := 0; i < len(left) || i < len(right); i++ {
+		var l, r int
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+
+		if l < r {
+			return -1
+		} else if l > r {
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionComponents(version string) []int {
+	ret := []int{}
+	for _, component := range strings.Split(version, ".") {
+		num, err := strconv.Atoi(component)
+		if err != nil {
+			break
+		}
+		ret = append(ret, num)
+	}
+	return ret
+}
+
+// listPageSize is the number of items requested at once from lists of the JSON API, so that listing the contents
+// of large controllers neither times out nor transfers the entire list in a single response.
+const listPageSize = 500
+
+// listItems pages through a list of the JSON API, such as the jobs of a folder, passing each item to the given func.
+// Only the given fields of each item are requested, as Jenkins would otherwise include far more than is needed.
+func listItems(ctx context.Context, client jenkinsClient, endpoint, list, fields string, each func(item json.RawMessage) error) error {
+	for start := 0; ; start += listPageSize {
+		query := map[string]string{"tree": fmt.Sprintf("%s[%s]{%d,%d}", list, fields, start, start+listPageSize)}
+		page := map[string]json.RawMessage{}
+		if err := client.GetJSON(ctx, endpoint, query, &page); err != nil {
+			return err
+		}
+
+		items := []json.RawMessage{}
+		if raw, ok := page[list]; ok {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return err
+			}
+		}
+		for _, item := range items {
+			if err := each(item); err != nil {
+				return err
+			}
+		}
+		if len(items) < listPageSize {
+			return nil
+		}
+	}
+}
+
+// matchName reports whether a name matches the pattern given to filter a list data source, if any.
+func matchName(d *schema.ResourceData, name string) bool {
+	pattern := d.Get("name_regex").(string)
+	if pattern == "" {
+		return true
+	}
+
+	// Patterns have already been validated by the schema
+	matched, _ := regexp.MatchString(pattern, name)
+	return matched
+}
+
 func generateCredentialID(folder, name string) string {
 	return fmt.Sprintf("%s/%s", folder, name)
 }
+
+// defaultResourceTimeouts bounds each operation against Jenkins, so that an unresponsive controller
+// fails the resource rather than stalling the entire apply.
+func defaultResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(5 * time.Minute),
+		Read:   schema.DefaultTimeout(5 * time.Minute),
+		Update: schema.DefaultTimeout(5 * time.Minute),
+		Delete: schema.DefaultTimeout(5 * time.Minute),
+	}
+}
+
+// retryResource adds the "retry" block to a resource, through which the requests made for it can be retried on
+// statuses that are only temporary for that resource, such as creating jobs right after the folder they are in.
+func retryResource(resource *schema.Resource) {
+	resource.Schema["retry"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Retries the requests made for this resource when Jenkins responds with one of the given statuses.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attempts": {
+					Type:        schema.TypeInt,
+					Description: "The number of times each request is retried.",
+					Optional:    true,
+					Default:     3,
+				},
+				"on_status": {
+					Type:        schema.TypeList,
+					Description: "The HTTP status codes to retry requests on.",
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+				},
+				"backoff": {
+					Type:             schema.TypeString,
+					Description:      "The delay before the first retry, which doubles for each retry after it.",
+					Optional:         true,
+					Default:          "1s",
+					ValidateDiagFunc: validateDuration,
+				},
+			},
+		},
+	}
+
+	resource.CreateContext = withResourceRetry(resource.CreateContext)
+	resource.ReadContext = withResourceRetry(resource.ReadContext)
+	resource.DeleteContext = withResourceRetry(resource.DeleteContext)
+	if resource.UpdateContext == nil {
+		// Changing the retry block alone leaves nothing to update in Jenkins
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		}
+	}
+	resource.UpdateContext = withResourceRetry(resource.UpdateContext)
+}
+
+func withResourceRetry(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(withRetryPolicy(ctx, expandRetryPolicy(d)), d, meta)
+	}
+}
+
+// structuredLog records entries with their fields attached to the entry, rather than formatted into its message.
+// The SDK serves the provider with hclog's JSON output, which Terraform parses back into the fields of each entry
+// in TF_LOG, as it does for the entries of tflog in newer SDKs.
+var structuredLog = hclog.New(&hclog.LoggerOptions{Name: "jenkins", Level: hclog.Trace, JSONFormat: true})
+
+// traceResource logs every operation on a resource once it has completed, with the fields that identify
+// what it was applied to, so that the operation can be traced through TF_LOG and matched with the requests
+// Jenkins logged for it.
+func traceResource(name string, resource *schema.Resource) {
+	resource.CreateContext = withTrace(name, "create", resource, resource.CreateContext)
+	resource.ReadContext = withTrace(name, "read", resource, resource.ReadContext)
+	resource.UpdateContext = withTrace(name, "update", resource, resource.UpdateContext)
+	resource.DeleteContext = withTrace(name, "delete", resource, resource.DeleteContext)
+}
+
+func withTrace(name, operation string, resource *schema.Resource, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		start := time.Now()
+		diags := f(ctx, d, meta)
+
+		fields := []interface{}{
+			"resource", name,
+			"operation", operation,
+			"id", d.Id(),
+			"duration_ms", time.Since(start).Milliseconds(),
+		}
+		for _, key := range []string{"folder", "domain"} {
+			if _, ok := resource.Schema[key]; ok {
+				fields = append(fields, key, d.Get(key))
+			}
+		}
+
+		// Warnings, such as those of the compatibility checks, are counted apart so as not to look like failures
+		failures, warnings := []string{}, 0
+		for _, entry := range diags {
+			if entry.Severity == diag.Error {
+				failures = append(failures, entry.Summary)
+			} else {
+				warnings++
+			}
+		}
+		fields = append(fields, "errors", len(failures), "warnings", warnings)
+		if len(failures) > 0 {
+			fields = append(fields, "error", strings.Join(failures, "; "))
+		}
+
+		structuredLog.Debug(fmt.Sprintf("jenkins::%s - Completed", operation), fields...)
+		return diags
+	}
+}
+
+// expandRetryPolicy reads the "retry" block of a resource, if it has one.
+func expandRetryPolicy(d *schema.ResourceData) *retryPolicy {
+	blocks := d.Get("retry").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	policy := &retryPolicy{attempts: block["attempts"].(int), onStatus: map[int]bool{}}
+	for _, status := range block["on_status"].([]interface{}) {
+		policy.onStatus[status.(int)] = true
+	}
+	// Durations have already been validated by the schema
+	policy.backoff, _ = time.ParseDuration(block["backoff"].(string))
+	return policy
+}
+
+// createdReadTimeout bounds how long a freshly created resource may take to be served by Jenkins.
+const createdReadTimeout = 30 * time.Second
+
+// readCreated refreshes a freshly created resource. Busy controllers occasionally do not serve a resource right
+// after creating it, which read would otherwise take as the resource being gone and remove it from state.
+func readCreated(ctx context.Context, d *schema.ResourceData, meta interface{}, read schema.ReadContextFunc) diag.Diagnostics {
+	id := d.Id()
+
+	var diags diag.Diagnostics
+	err := resource.RetryContext(ctx, createdReadTimeout, func() *resource.RetryError {
+		diags = read(ctx, d, meta)
+		if diags.HasError() || d.Id() != "" {
+			return nil
+		}
+
+		log.Printf("[DEBUG] jenkins::create - %q is not available yet", id)
+		d.SetId(id)
+		return resource.RetryableError(fmt.Errorf("%q was not found after creating it", id))
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("jenkins::create - Error reading %q: %w", id, err))
+	}
+	return diags
+}
+
+// waitForJenkins blocks until Jenkins is responding and is no longer preparing to restart.
+// When the provider is given a health endpoint, that endpoint responding is taken as Jenkins being ready instead.
+func waitForJenkins(ctx context.Context, client jenkinsClient, timeout time.Duration) error {
+	checker, ok := client.(interface {
+		HealthEndpoint() string
+		CheckHealth(ctx context.Context) error
+	})
+	if ok && checker.HealthEndpoint() != "" {
+		return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			if err := checker.CheckHealth(ctx); err != nil && isAuthError(err) {
+				return resource.NonRetryableError(err)
+			} else if err != nil {
+				log.Printf("[DEBUG] jenkins::wait - %s is not healthy: %v", checker.HealthEndpoint(), err)
+				return resource.RetryableError(err)
+			}
+			return nil
+		})
+	}
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		info, err := client.ServerInfo(ctx)
+		if err != nil && isAuthError(err) {
+			// Waiting will not make rejected credentials valid
+			return resource.NonRetryableError(err)
+		} else if err != nil {
+			log.Printf("[DEBUG] jenkins::wait - Jenkins is unavailable: %v", err)
+			return resource.RetryableError(err)
+		}
+
+		if info.QuietingDown {
+			return resource.RetryableError(fmt.Errorf("Jenkins is preparing to restart"))
+		}
+
+		return nil
+	})
+}
+
+// isNotFound reports whether Jenkins responded that the requested item does not exist.
+func isNotFound(err error) bool {
+	var status *statusError
+	return errors.As(err, &status) && (status.StatusCode == http.StatusNotFound || status.StatusCode == http.StatusGone)
+}
+
+// isAuthError reports whether a request was rejected by Jenkins because of the provided credentials.
+func isAuthError(err error) bool {
+	var status *statusError
+	return errors.As(err, &status) && (status.StatusCode == http.StatusUnauthorized || status.StatusCode == http.StatusForbidden)
+}
+
+// describeAuthError adds guidance to errors caused by Jenkins rejecting the provider's credentials,
+// which would otherwise only show as a bare status code.
+func describeAuthError(err error) error {
+	var status *statusError
+	if !errors.As(err, &status) {
+		return err
+	}
+
+	switch status.StatusCode {
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%w: Jenkins did not accept the provider's credentials", err)
+	case http.StatusForbidden:
+		return fmt.Errorf("%w: the provider's user is not permitted to access this item", err)
+	}
+	return err
+}
+
+var (
+	errorPageIgnored    = regexp.MustCompile(`(?is)<(script|style|head)\b.*?</(script|style|head)>`)
+	errorPageTags       = regexp.MustCompile(`(?s)<[^>]*>`)
+	errorPageException  = regexp.MustCompile(`^(?:Caused by: )?(?:[a-zA-Z_$][\w$]*\.)+[\w$]*(?:Exception|Error)(?::.*)?$`)
+	errorPagePermission = regexp.MustCompile(`\bis missing the [\w/ ]+ permission\b`)
+)
+
+// maxErrorMessageLength caps the length of messages taken from error pages.
+const maxErrorMessageLength = 300
+
+// describeErrorPage reduces the body of an unsuccessful response, typically an HTML page with a stack trace,
+// to the exception it reports. The root cause is preferred over the exceptions wrapping it.
+// An empty string is returned when the page does not report anything more specific than its status.
+func describeErrorPage(body string) string {
+	text := html.UnescapeString(errorPageTags.ReplaceAllString(errorPageIgnored.ReplaceAllString(body, ""), "\n"))
+
+	message := ""
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if errorPageException.MatchString(line) {
+			if message == "" || strings.HasPrefix(line, "Caused by: ") {
+				message = strings.TrimPrefix(line, "Caused by: ")
+			}
+		} else if message == "" && errorPagePermission.MatchString(line) {
+			message = line
+		}
+	}
+
+	if len(message) > maxErrorMessageLength {
+		message = message[:maxErrorMessageLength] + "..."
+	}
+	return message
+}
+
+// errorHint suggests the likely cause of the more common errors reported by Jenkins.
+func errorHint(message string) string {
+	switch {
+	case strings.Contains(message, "CannotResolveClassException"), strings.Contains(message, "ClassNotFoundException"):
+		return "the XML refers to an element that Jenkins does not know, usually because the plugin providing it is not installed"
+	case strings.Contains(message, "ConversionException"), strings.Contains(message, "XStreamException"):
+		return "the XML contains an element or value that Jenkins could not read"
+	case strings.Contains(message, "SAXParseException"), strings.Contains(message, "XmlPullParserException"):
+		return "the XML is not well-formed"
+	case strings.Contains(message, "AccessDeniedException"), errorPagePermission.MatchString(message):
+		return "the provider's user is missing a permission required for this action"
+	}
+	return ""
+}
+
+// restartJenkins performs a safe restart of Jenkins, waiting until it is available again.
+func restartJenkins(ctx context.Context, client jenkinsClient, timeout time.Duration) error {
+	if err := client.SafeRestart(ctx); err != nil {
+		return err
+	}
+
+	return waitForJenkins(ctx, client, timeout)
+}
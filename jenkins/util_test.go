@@ -1,7 +1,21 @@
 package jenkins
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestFormatFolderName(t *testing.T) {
@@ -78,6 +92,119 @@ func TestParseCanonicalJobID(t *testing.T) {
 	}
 }
 
+func TestResolveCredentialsFolder(t *testing.T) {
+	meta := &defaultFolderClient{folder: "team-a"}
+
+	if actual := resolveCredentialsFolder(meta, "", "GLOBAL"); actual != "team-a" {
+		t.Errorf("Expected the default folder to apply but received %q", actual)
+	}
+	if actual := resolveCredentialsFolder(meta, "", "SYSTEM"); actual != "" {
+		t.Errorf("Expected SYSTEM credentials to stay in the system store but received %q", actual)
+	}
+}
+
+func TestParseImportID(t *testing.T) {
+	tests := map[string][]string{
+		"deploy":                                      {"", "deploy"},
+		"team/builds/deploy":                          {"team/builds", "deploy"},
+		"/job/team/job/builds/deploy":                 {"team/builds", "deploy"},
+		"team/job/builds/job/deploy":                  {"team/builds", "deploy"},
+		"/job/team/job/job/job/deploy":                {"team/job", "deploy"},
+		"team/my%20builds/deploy":                     {"team/my builds", "deploy"},
+		"https://ci.example.com/job/team/job/deploy/": {"team", "deploy"},
+		"https://ci.example.com/ci/jenkins/job/team/job/builds/job/deploy": {"team/builds", "deploy"},
+	}
+	for input, expected := range tests {
+		folders, segments, err := parseImportID(input, 1)
+		if err != nil {
+			t.Errorf("Expected %q to parse but received: %s", input, err)
+		} else if strings.Join(folders, "/") != expected[0] || segments[0] != expected[1] {
+			t.Errorf("Expected %q to parse as %q but received %q %q", input, expected, folders, segments)
+		}
+	}
+
+	// Credentials may be given by their URL within a store
+	folders, segments, err := parseImportID("https://ci.example.com/job/team/credentials/store/folder/domain/_/credential/deploy-key/", 2)
+	if err != nil || strings.Join(folders, "/") != "team" || strings.Join(segments, "/") != "_/deploy-key" {
+		t.Errorf("Expected the credential URL to parse but received %q %q %v", folders, segments, err)
+	}
+	folders, segments, err = parseImportID("https://ci.example.com/credentials/store/system/domain/ssh/credential/deploy-key", 2)
+	if err != nil || len(folders) != 0 || strings.Join(segments, "/") != "ssh/deploy-key" {
+		t.Errorf("Expected the system credential URL to parse but received %q %q %v", folders, segments, err)
+	}
+
+	// Test if we fail when we should
+	for _, input := range []string{"deploy", "https://ci.example.com/", "team/bad%zz/deploy"} {
+		if _, _, err := parseImportID(input, 2); err == nil {
+			t.Errorf("Expected %q to fail to parse", input)
+		}
+	}
+}
+
+func TestImportFolder(t *testing.T) {
+	if actual := importFolder(&mockJenkinsClient{}, []string{"team", "builds"}); actual != "team/job/builds" {
+		t.Errorf("Expected the folder path but received %q", actual)
+	}
+
+	meta := &defaultFolderClient{folder: "team"}
+	if actual := importFolder(meta, []string{"team", "builds"}); actual != "builds" {
+		t.Errorf("Expected the folder relative to the default but received %q", actual)
+	}
+	if actual := importFolder(meta, []string{"other", "builds"}); actual != "/job/other/job/builds" {
+		t.Errorf("Expected the absolute folder but received %q", actual)
+	}
+}
+
+type folderCacheClient struct {
+	mockJenkinsClient
+	cache *folderCache
+}
+
+func (c *folderCacheClient) FolderCache() *folderCache {
+	return c.cache
+}
+
+func TestFolderExists_cached(t *testing.T) {
+	calls := 0
+	client := &folderCacheClient{cache: newFolderCache(time.Minute)}
+	client.mockGetFolder = func(ctx context.Context, id string, parents ...string) (*jobItem, error) {
+		calls++
+		return &jobItem{}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := folderExists(context.Background(), client, "/job/team/job/builds"); err != nil {
+			t.Fatalf("Expected the folder to exist but received: %s", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 folder lookup but received %d", calls)
+	}
+
+	// Deleting a parent folder must also forget the folders within it
+	client.cache.remove("team")
+	if err := folderExists(context.Background(), client, "team/builds"); err != nil {
+		t.Fatalf("Expected the folder to exist but received: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 folder lookups but received %d", calls)
+	}
+}
+
+func TestFolderCache_expiry(t *testing.T) {
+	cache := newFolderCache(-time.Second)
+	cache.add("team")
+	if cache.exists("team") {
+		t.Errorf("Expected expired folders to be forgotten")
+	}
+
+	var unset *folderCache
+	unset.add("team")
+	if unset.exists("team") {
+		t.Errorf("Expected a nil cache to cache nothing")
+	}
+}
+
 func TestTemplateDiff(t *testing.T) {
 	// Set up inputs
 	inputLeft := "<?xml version=\"1.0\" encoding=\"UTF-8\"?><root>Test Case</root>"
@@ -109,6 +236,72 @@ func TestTemplateDiff(t *testing.T) {
 	}
 }
 
+func TestNormalizeServerURL(t *testing.T) {
+	tests := map[string]string{
+		"https://jenkins.example.com":                "https://jenkins.example.com",
+		"https://jenkins.example.com/":               "https://jenkins.example.com",
+		"https://jenkins.example.com/ci/jenkins/":    "https://jenkins.example.com/ci/jenkins",
+		"https://jenkins.example.com//ci//jenkins//": "https://jenkins.example.com/ci/jenkins",
+		"https://jenkins.example.com/ci%20jenkins":   "https://jenkins.example.com/ci%20jenkins",
+	}
+	for input, expected := range tests {
+		if actual := normalizeServerURL(input); actual != expected {
+			t.Errorf("Expected %s to be normalized to %s but got: %s", input, expected, actual)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"2.361.1", "2.361.1", 0},
+		{"2.361", "2.361.0", 0},
+		{"2.346.3", "2.361.1", -1},
+		{"2.401.1", "2.361.1", 1},
+		{"1319.v7eb_51b_3a_c97b_", "2.6.1", 1},
+		{"2.6.1-SNAPSHOT", "2.6.2", -1},
+	}
+	for _, tt := range tests {
+		if actual := compareVersions(tt.a, tt.b); actual != tt.expected {
+			t.Errorf("Expected comparing %s to %s to be %d but got: %d", tt.a, tt.b, tt.expected, actual)
+		}
+	}
+}
+
+func TestListItems(t *testing.T) {
+	queries := []string{}
+	client := &mockJenkinsClient{
+		mockGetJSON: func(ctx context.Context, endpoint string, query map[string]string, v interface{}) error {
+			queries = append(queries, query["tree"])
+
+			// Serve one full page followed by a partial one
+			items := []string{}
+			count := listPageSize
+			if len(queries) > 1 {
+				count = 3
+			}
+			for i := 0; i < count; i++ {
+				items = append(items, fmt.Sprintf(`{"name": "job-%d"}`, i))
+			}
+			return json.Unmarshal([]byte(`{"_class": "hudson.model.Hudson", "jobs": [`+strings.Join(items, ",")+`]}`), v)
+		},
+	}
+
+	count := 0
+	err := listItems(context.Background(), client, "/", "jobs", "name", func(item json.RawMessage) error {
+		count++
+		return nil
+	})
+	if err != nil || count != listPageSize+3 {
+		t.Errorf("Expected every page to be listed but received %d items: %v", count, err)
+	}
+	if len(queries) != 2 || queries[1] != fmt.Sprintf("jobs[name]{%d,%d}", listPageSize, 2*listPageSize) {
+		t.Errorf("Expected the second page to be requested but received %q", queries)
+	}
+}
+
 func TestGenerateCredentialID(t *testing.T) {
 	inputFolder, inputName := "test-folder", "test-name"
 	actual := generateCredentialID(inputFolder, inputName)
@@ -116,3 +309,310 @@ func TestGenerateCredentialID(t *testing.T) {
 		t.Errorf("Expected %s/%s but got: %s", inputFolder, inputName, actual)
 	}
 }
+
+func TestExpandRetryPolicy(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{}}
+	retryResource(resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"retry": []interface{}{map[string]interface{}{"on_status": []interface{}{500, 502}}},
+	})
+	policy := expandRetryPolicy(d)
+	if policy == nil || policy.attempts != 3 || !policy.onStatus[502] || policy.onStatus[404] || policy.backoff != time.Second {
+		t.Errorf("Expected the retry block with its defaults but received %+v", policy)
+	}
+
+	if policy := expandRetryPolicy(schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})); policy != nil {
+		t.Errorf("Expected no retries without a retry block but received %+v", policy)
+	}
+}
+
+func TestReadCreated(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceJenkinsFolder().Schema, map[string]interface{}{})
+	d.SetId("team")
+
+	reads := 0
+	read := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// The first read does not find the resource yet
+		if reads++; reads == 1 {
+			d.SetId("")
+		}
+		return nil
+	}
+	if diags := readCreated(context.Background(), d, nil, read); diags.HasError() || d.Id() != "team" || reads != 2 {
+		t.Errorf("Expected the resource to be read again, but got %v after %d reads", diags, reads)
+	}
+
+	// Test if we fail when we should
+	failing := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		reads++
+		return diag.Errorf("omg")
+	}
+	reads = 0
+	if diags := readCreated(context.Background(), d, nil, failing); !diags.HasError() || reads != 1 {
+		t.Errorf("Expected errors not to be retried, but got %v after %d reads", diags, reads)
+	}
+}
+
+func TestTraceResource(t *testing.T) {
+	output := &bytes.Buffer{}
+	previous := structuredLog
+	structuredLog = hclog.New(&hclog.LoggerOptions{Level: hclog.Trace, JSONFormat: true, Output: output})
+	defer func() { structuredLog = previous }()
+
+	tests := []struct {
+		name     string
+		diags    diag.Diagnostics
+		errors   float64
+		warnings float64
+		error    interface{}
+	}{
+		{
+			name:   "failed",
+			diags:  diag.Errorf("omg"),
+			errors: 1,
+			error:  "omg",
+		},
+		{
+			name:     "warned",
+			diags:    diag.Diagnostics{{Severity: diag.Warning, Summary: "Unsupported Jenkins version"}},
+			warnings: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output.Reset()
+			resource := resourceJenkinsFolder()
+			called := false
+			resource.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				called = true
+				return tt.diags
+			}
+			traceResource("jenkins_folder", resource)
+
+			d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"folder": "team"})
+			if diags := resource.DeleteContext(context.Background(), d, nil); !called || len(diags) != 1 {
+				t.Errorf("Expected the operation to be called through, but got %v", diags)
+			}
+
+			// The fields are attached to the entry, with warnings not counted as errors
+			entry := map[string]interface{}{}
+			if err := json.Unmarshal(output.Bytes(), &entry); err != nil {
+				t.Fatalf("Expected a structured entry but received %q: %v", output.String(), err)
+			}
+			if entry["resource"] != "jenkins_folder" || entry["operation"] != "delete" || entry["folder"] != "team" {
+				t.Errorf("Expected the resource to be identified, but got %v", entry)
+			}
+			if entry["errors"] != tt.errors || entry["warnings"] != tt.warnings || entry["error"] != tt.error {
+				t.Errorf("Expected %v errors and %v warnings, but got %v", tt.errors, tt.warnings, entry)
+			}
+		})
+	}
+}
+
+func TestWaitForJenkins(t *testing.T) {
+	calls := 0
+	client := &mockJenkinsClient{
+		mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+			calls++
+			return &serverInfo{QuietingDown: calls < 2}, nil
+		},
+	}
+
+	if err := waitForJenkins(context.Background(), client, time.Minute); err != nil {
+		t.Errorf("Expected Jenkins to become available but received: %s", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 status checks but received %d", calls)
+	}
+}
+
+func TestWaitForJenkins_unauthorized(t *testing.T) {
+	calls := 0
+	client := &mockJenkinsClient{
+		mockServerInfo: func(ctx context.Context) (*serverInfo, error) {
+			calls++
+			return nil, newStatusError(http.StatusUnauthorized, "")
+		},
+	}
+
+	if err := waitForJenkins(context.Background(), client, time.Minute); err == nil {
+		t.Errorf("Expected rejected credentials to be reported")
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 status check but received %d", calls)
+	}
+}
+
+func TestWaitForJenkins_healthEndpoint(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ci/jenkins/login" {
+			t.Errorf("Expected the health endpoint to be requested but received %s", r.URL.Path)
+		}
+		if calls++; calls < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := newJenkinsClient(&Config{ServerURL: server.URL + "/ci/jenkins/", HealthEndpoint: "/login"})
+	if err := waitForJenkins(context.Background(), client, time.Minute); err != nil {
+		t.Errorf("Expected Jenkins to become available but received: %s", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 health checks but received %d", calls)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := map[error]bool{
+		newStatusError(http.StatusNotFound, ""):                            true,
+		newStatusError(http.StatusGone, ""):                                true,
+		fmt.Errorf("wrapped: %w", newStatusError(http.StatusNotFound, "")): true,
+		newStatusError(http.StatusForbidden, ""):                           false,
+		fmt.Errorf("received a 404"):                                       false,
+	}
+	for err, expected := range tests {
+		if actual := isNotFound(err); actual != expected {
+			t.Errorf("Expected isNotFound(%q) to be %t", err, expected)
+		}
+	}
+}
+
+func TestDescribeAuthError(t *testing.T) {
+	err := describeAuthError(newStatusError(http.StatusUnauthorized, ""))
+	if !isAuthError(err) {
+		t.Errorf("Expected the status to be preserved, but got: %s", err)
+	} else if err.Error() != "401 Unauthorized: Jenkins did not accept the provider's credentials" {
+		t.Errorf("Expected guidance to be added, but got: %s", err)
+	}
+
+	if err := describeAuthError(newStatusError(http.StatusInternalServerError, "")); err.Error() != "500 Internal Server Error" {
+		t.Errorf("Expected other errors to be unchanged, but got: %s", err)
+	}
+}
+
+func TestDescribeErrorPage(t *testing.T) {
+	page := `<html><head><title>Jenkins</title><script>var crumb = "x";</script></head><body>
+<h2>Oops!</h2><pre>java.io.IOException: Unable to read job config
+	at hudson.model.Items.load(Items.java:372)
+Caused by: com.thoughtworks.xstream.mapper.CannotResolveClassException: org.example.MissingBuilder
+	at com.thoughtworks.xstream.mapper.DefaultMapper.realClass(DefaultMapper.java:81)
+</pre></body></html>`
+	if actual := describeErrorPage(page); actual != "com.thoughtworks.xstream.mapper.CannotResolveClassException: org.example.MissingBuilder" {
+		t.Errorf("Expected the root cause to be reported, but got %q", actual)
+	}
+
+	denied := "<html><body><h1>Access Denied</h1><p>admin is missing the Overall/Administer permission</p></body></html>"
+	if actual := describeErrorPage(denied); actual != "admin is missing the Overall/Administer permission" {
+		t.Errorf("Expected the missing permission to be reported, but got %q", actual)
+	}
+
+	if actual := describeErrorPage("java.lang.IllegalStateException: " + strings.Repeat("a", 400)); len(actual) != maxErrorMessageLength+3 {
+		t.Errorf("Expected long messages to be truncated, but got %d characters", len(actual))
+	}
+
+	// Test if we fail when we should
+	if actual := describeErrorPage("<html><body>Not Found</body></html>"); actual != "" {
+		t.Errorf("Expected pages without an exception to be ignored, but got %q", actual)
+	}
+}
+
+func TestErrorHint(t *testing.T) {
+	tests := map[string]string{
+		"com.thoughtworks.xstream.mapper.CannotResolveClassException: org.example.MissingBuilder":   "the XML refers to an element that Jenkins does not know, usually because the plugin providing it is not installed",
+		"com.thoughtworks.xstream.converters.ConversionException: No such field":                    "the XML contains an element or value that Jenkins could not read",
+		"org.xml.sax.SAXParseException: XML document structures must start and end within the same": "the XML is not well-formed",
+		"admin is missing the Job/Create permission":                                                "the provider's user is missing a permission required for this action",
+		"A job already exists with the name 'build'":                                                "",
+	}
+	for message, expected := range tests {
+		if actual := errorHint(message); actual != expected {
+			t.Errorf("Expected errorHint(%q) to be %q, but got %q", message, expected, actual)
+		}
+	}
+}
+
+func TestReadPEM(t *testing.T) {
+	inline := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	actual, err := readPEM(inline)
+	if err != nil || string(actual) != inline {
+		t.Errorf("Expected inline PEM data to be returned as-is, received %q, %v", actual, err)
+	}
+
+	file := filepath.Join(t.TempDir(), "cert.pem")
+	if err := ioutil.WriteFile(file, []byte(inline), 0600); err != nil {
+		t.Fatal(err)
+	}
+	actual, err = readPEM(file)
+	if err != nil || string(actual) != inline {
+		t.Errorf("Expected PEM data to be read from %s, received %q, %v", file, actual, err)
+	}
+
+	// Test if we fail when we should
+	if _, err := readPEM(filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Errorf("Expected a missing file to be rejected")
+	}
+}
+
+type defaultFolderClient struct {
+	mockJenkinsClient
+	folder string
+}
+
+func (c *defaultFolderClient) DefaultFolder() string {
+	return c.folder
+}
+
+func TestResolveFolder(t *testing.T) {
+	meta := &defaultFolderClient{folder: "team-a"}
+
+	tests := map[string]string{
+		"":              "team-a",
+		"builds":        "team-a/builds",
+		"/job/shared":   "/job/shared",
+		"/other/folder": "/other/folder",
+	}
+	for input, expected := range tests {
+		if actual := resolveFolder(meta, input); actual != expected {
+			t.Errorf("Expected %q to resolve to %q but received %q", input, expected, actual)
+		}
+	}
+
+	// Without a default folder nothing changes
+	if actual := resolveFolder(&mockJenkinsClient{}, "builds"); actual != "builds" {
+		t.Errorf("Expected %q but received %q", "builds", actual)
+	}
+}
+
+func TestFlattenFolder(t *testing.T) {
+	meta := &defaultFolderClient{folder: "team-a"}
+
+	if actual := flattenFolder(meta, "builds", []string{"team-a", "builds"}); actual != "builds" {
+		t.Errorf("Expected the relative folder to be kept but received %q", actual)
+	}
+
+	if actual := flattenFolder(meta, "builds", []string{"team-b", "builds"}); actual != "/job/team-b/job/builds" {
+		t.Errorf("Expected the absolute folder but received %q", actual)
+	}
+}
+
+func (c *defaultFolderClient) DefaultCredentialsDomain() string {
+	return "team-a"
+}
+
+func TestResolveCredentialsDomain(t *testing.T) {
+	if actual := resolveCredentialsDomain(&defaultFolderClient{}, ""); actual != "team-a" {
+		t.Errorf("Expected the default domain but received %q", actual)
+	}
+	if actual := resolveCredentialsDomain(&defaultFolderClient{}, "other"); actual != "other" {
+		t.Errorf("Expected the given domain but received %q", actual)
+	}
+	if actual := resolveCredentialsDomain(&mockJenkinsClient{}, ""); actual != "_" {
+		t.Errorf("Expected the global domain but received %q", actual)
+	}
+}
@@ -2,10 +2,15 @@ package jenkins
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"golang.org/x/net/http/httpguts"
 )
 
 func validateJobName(val interface{}, path cty.Path) diag.Diagnostics {
@@ -16,7 +21,46 @@ func validateJobName(val interface{}, path cty.Path) diag.Diagnostics {
 	return diag.Diagnostics{}
 }
 
+// unsafeNameCharacters are the characters that Jenkins does not allow within the names of items.
+const unsafeNameCharacters = "?*\\%!@#$^&|<>[]:;"
+
+// validateFolderName checks that a folder is either a path of folder names ("parent/child") or in the canonical form
+// used by Jenkins ("parent/job/child"), which may start with "/job/" to not have the provider's default folder applied.
 func validateFolderName(val interface{}, path cty.Path) diag.Diagnostics {
+	folder := val.(string)
+	if folder == "" {
+		return diag.Diagnostics{}
+	}
+
+	segments := strings.Split(strings.TrimPrefix(folder, "/"), "/")
+	absolute := strings.HasPrefix(folder, "/")
+	if absolute && segments[0] != "job" {
+		return diag.Errorf("Invalid folder: %s. Folders may only start with a \"/\" in the canonical \"/job/<name>\" form", folder)
+	}
+
+	canonical := false
+	for _, segment := range segments {
+		if segment == "" {
+			return diag.Errorf("Invalid folder: %s. Folders may not contain empty segments or end with a \"/\"", folder)
+		} else if segment == "." || segment == ".." || strings.ContainsAny(segment, unsafeNameCharacters) {
+			return diag.Errorf("Invalid folder: %s. Folder names may not be \".\" or \"..\", nor contain any of %s", folder, unsafeNameCharacters)
+		}
+		canonical = canonical || segment == "job"
+	}
+
+	// Once a "job" separator is used, every other segment must be one so that folder names are unambiguous
+	if canonical {
+		offset := 1
+		if absolute {
+			offset = 0
+		}
+		for i, segment := range segments {
+			if (i%2 == offset && segment != "job") || (i == len(segments)-1 && i%2 == offset) {
+				return diag.Errorf("Invalid folder: %s. Folders using \"job\" separators must alternate them with folder names, as in \"parent/job/child\"", folder)
+			}
+		}
+	}
+
 	return diag.Diagnostics{}
 }
 
@@ -29,3 +73,177 @@ func validateCredentialScope(val interface{}, path cty.Path) diag.Diagnostics {
 	}
 	return diag.Errorf("Invalid scope: %s. Supported scopes are: %s", val, strings.Join(supportedCredentialScopes, ", "))
 }
+
+func validateCredentialsCheck(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedChecks = []string{"off", "warn", "error"}
+	for _, supported := range supportedChecks {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid credentials check: %s. Supported checks are: %s", val, strings.Join(supportedChecks, ", "))
+}
+
+func validateLoadTimescale(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedTimescales = []string{"sec10", "min", "hour"}
+	for _, supported := range supportedTimescales {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid timescale: %s. Supported timescales are: %s", val, strings.Join(supportedTimescales, ", "))
+}
+
+func validateEmailContentType(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedContentTypes = []string{"text/plain", "text/html"}
+	for _, supported := range supportedContentTypes {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid content type: %s. Supported content types are: %s", val, strings.Join(supportedContentTypes, ", "))
+}
+
+func validateGitHubRateLimitChecker(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedCheckers = []string{"ThrottleForNormalize", "ThrottleOnOver", "NoThrottle"}
+	for _, supported := range supportedCheckers {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid rate limit strategy: %s. Supported strategies are: %s", val, strings.Join(supportedCheckers, ", "))
+}
+
+func validateDurabilityHint(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedHints = []string{"PERFORMANCE_OPTIMIZED", "SURVIVABLE_NONATOMIC", "MAX_SURVIVABILITY"}
+	for _, supported := range supportedHints {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid durability hint: %s. Supported hints are: %s", val, strings.Join(supportedHints, ", "))
+}
+
+func validateLogLevel(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedLevels = []string{"OFF", "SEVERE", "WARNING", "INFO", "CONFIG", "FINE", "FINER", "FINEST", "ALL"}
+	for _, supported := range supportedLevels {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid log level: %s. Supported levels are: %s", val, strings.Join(supportedLevels, ", "))
+}
+
+func validateAuthorizationType(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedTypes = []string{"USER", "GROUP", "EITHER"}
+	for _, supported := range supportedTypes {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid principal type: %s. Supported types are: %s", val, strings.Join(supportedTypes, ", "))
+}
+
+func validateUsernameCaseConversion(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedConversions = []string{"none", "lowercase", "uppercase"}
+	for _, supported := range supportedConversions {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid username case conversion: %s. Supported conversions are: %s", val, strings.Join(supportedConversions, ", "))
+}
+
+func validateSAMLBinding(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedBindings = []string{"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"}
+	for _, supported := range supportedBindings {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid SAML binding: %s. Supported bindings are: %s", val, strings.Join(supportedBindings, ", "))
+}
+
+func validateMarkupFormatter(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedFormatters = []string{"plain_text", "safe_html"}
+	for _, supported := range supportedFormatters {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid markup formatter: %s. Supported formatters are: %s", val, strings.Join(supportedFormatters, ", "))
+}
+
+func validateSSHDMode(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedModes = []string{"fixed", "random", "disabled"}
+	for _, supported := range supportedModes {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid SSHD mode: %s. Supported modes are: %s", val, strings.Join(supportedModes, ", "))
+}
+
+func validateBuild(val interface{}, path cty.Path) diag.Diagnostics {
+	var supportedPermalinks = []string{"lastBuild", "lastCompletedBuild", "lastSuccessfulBuild", "lastStableBuild"}
+	for _, supported := range supportedPermalinks {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	if number, err := strconv.Atoi(val.(string)); err == nil && number > 0 {
+		return diag.Diagnostics{}
+	}
+	return diag.Errorf("Invalid build: %s. Builds are given as their number or one of: %s", val, strings.Join(supportedPermalinks, ", "))
+}
+
+func validatePositive(val interface{}, path cty.Path) diag.Diagnostics {
+	if val.(int) <= 0 {
+		return diag.Errorf("Invalid value: %d. The value must be greater than zero", val)
+	}
+	return diag.Diagnostics{}
+}
+
+func validateDuration(val interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := time.ParseDuration(val.(string)); err != nil {
+		return diag.Errorf("Invalid duration: %s. Durations are given as a number and unit, such as \"30s\" or \"5m\"", val)
+	}
+	return diag.Diagnostics{}
+}
+
+func validateRegex(val interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := regexp.Compile(val.(string)); err != nil {
+		return diag.Errorf("Invalid regular expression: %s. %s", val, err)
+	}
+	return diag.Diagnostics{}
+}
+
+func validateHeaders(val interface{}, path cty.Path) diag.Diagnostics {
+	for name := range val.(map[string]interface{}) {
+		if !httpguts.ValidHeaderFieldName(name) {
+			return diag.Errorf("Invalid header name: %q", name)
+		}
+	}
+	return diag.Diagnostics{}
+}
+
+func validateServerURL(val interface{}, path cty.Path) diag.Diagnostics {
+	u, err := url.Parse(val.(string))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return diag.Errorf("Invalid server URL: %s. The URL must be fully qualified, such as \"https://jenkins.example.com/jenkins\"", val)
+	} else if u.RawQuery != "" || u.Fragment != "" {
+		return diag.Errorf("Invalid server URL: %s. The URL cannot include a query or fragment", val)
+	}
+	return diag.Diagnostics{}
+}
+
+func validateHealthEndpoint(val interface{}, path cty.Path) diag.Diagnostics {
+	endpoint := val.(string)
+	if strings.HasPrefix(endpoint, "/") {
+		return diag.Diagnostics{}
+	}
+	if u, err := url.Parse(endpoint); err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+		return diag.Diagnostics{}
+	}
+	return diag.Errorf("Invalid health endpoint: %s. Endpoints are either a path relative to the server URL, such as \"/login\", or a fully qualified URL", val)
+}
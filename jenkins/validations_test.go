@@ -25,11 +25,20 @@ func TestValidateJobName(t *testing.T) {
 
 func TestValidateFolderName(t *testing.T) {
 
-	input, ctyPath := "folder_name", make(cty.Path, 0)
-	actual := validateFolderName(input, ctyPath)
+	ctyPath := make(cty.Path, 0)
+	for _, input := range []string{"", "folder_name", "parent/child", "parent/job/child", "/job/parent/job/child", "parent/job/job"} {
+		actual := validateFolderName(input, ctyPath)
+		if actual.HasError() {
+			t.Errorf("Error, validation failed for input: %s", input)
+		}
+	}
 
-	if actual.HasError() {
-		t.Errorf("Error, validation failed for input: %s", input)
+	// Test if we fail when we should
+	for _, input := range []string{"/parent", "parent/", "parent//child", "parent/../child", "folder:name", "job/parent", "parent/job", "/job/parent/job", "parent/child/job/other"} {
+		actual := validateFolderName(input, ctyPath)
+		if !actual.HasError() {
+			t.Errorf("Error, negative validation failed for input: %s", input)
+		}
 	}
 }
 
@@ -54,3 +63,285 @@ func TestValidateCredentialScope(t *testing.T) {
 		t.Errorf("Error, negative validation failed for input: %s", input)
 	}
 }
+
+func TestValidateCredentialsCheck(t *testing.T) {
+	ctyPath := make(cty.Path, 0)
+	for _, input := range []string{"off", "warn", "error"} {
+		if actual := validateCredentialsCheck(input, ctyPath); actual.HasError() {
+			t.Errorf("Error, validation failed for input: %s", input)
+		}
+	}
+
+	// Test if we fail when we should
+	input := "WARN"
+	if actual := validateCredentialsCheck(input, ctyPath); !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateLoadTimescale(t *testing.T) {
+	ctyPath := make(cty.Path, 0)
+	for _, input := range []string{"sec10", "min", "hour"} {
+		if actual := validateLoadTimescale(input, ctyPath); actual.HasError() {
+			t.Errorf("Error, validation failed for input: %s", input)
+		}
+	}
+
+	// Test if we fail when we should
+	input := "day"
+	if actual := validateLoadTimescale(input, ctyPath); !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateEmailContentType(t *testing.T) {
+
+	input, ctyPath := "text/html", make(cty.Path, 0)
+	actual := validateEmailContentType(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "application/json"
+	actual = validateEmailContentType(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateGitHubRateLimitChecker(t *testing.T) {
+
+	input, ctyPath := "ThrottleOnOver", make(cty.Path, 0)
+	actual := validateGitHubRateLimitChecker(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "Throttle"
+	actual = validateGitHubRateLimitChecker(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateDurabilityHint(t *testing.T) {
+
+	input, ctyPath := "PERFORMANCE_OPTIMIZED", make(cty.Path, 0)
+	actual := validateDurabilityHint(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "performance-optimized"
+	actual = validateDurabilityHint(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateLogLevel(t *testing.T) {
+
+	input, ctyPath := "FINEST", make(cty.Path, 0)
+	actual := validateLogLevel(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "DEBUG"
+	actual = validateLogLevel(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateAuthorizationType(t *testing.T) {
+
+	input, ctyPath := "GROUP", make(cty.Path, 0)
+	actual := validateAuthorizationType(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "group"
+	actual = validateAuthorizationType(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateUsernameCaseConversion(t *testing.T) {
+
+	input, ctyPath := "lowercase", make(cty.Path, 0)
+	actual := validateUsernameCaseConversion(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "Lowercase"
+	actual = validateUsernameCaseConversion(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateSAMLBinding(t *testing.T) {
+
+	input, ctyPath := "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST", make(cty.Path, 0)
+	actual := validateSAMLBinding(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "HTTP-POST"
+	actual = validateSAMLBinding(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateMarkupFormatter(t *testing.T) {
+
+	input, ctyPath := "safe_html", make(cty.Path, 0)
+	actual := validateMarkupFormatter(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "raw_html"
+	actual = validateMarkupFormatter(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateSSHDMode(t *testing.T) {
+
+	input, ctyPath := "disabled", make(cty.Path, 0)
+	actual := validateSSHDMode(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "off"
+	actual = validateSSHDMode(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	input, ctyPath := "1m30s", make(cty.Path, 0)
+	actual := validateDuration(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "30"
+	actual = validateDuration(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+}
+
+func TestValidateRegex(t *testing.T) {
+	input, ctyPath := "^team-.*$", make(cty.Path, 0)
+	actual := validateRegex(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "team-("
+	actual = validateRegex(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateHeaders(t *testing.T) {
+	input, ctyPath := map[string]interface{}{"CF-Access-Client-Id": "id"}, make(cty.Path, 0)
+	actual := validateHeaders(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %v", input)
+	}
+
+	// Test if we fail when we should
+	input = map[string]interface{}{"X Tenant": "team"}
+	actual = validateHeaders(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, validation failed for input: %v", input)
+	}
+}
+
+func TestValidateServerURL(t *testing.T) {
+	input, ctyPath := "https://jenkins.example.com/ci/jenkins/", make(cty.Path, 0)
+	actual := validateServerURL(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	for _, input := range []string{"jenkins.example.com", "ftp://jenkins.example.com", "https://jenkins.example.com/?view=all"} {
+		actual = validateServerURL(input, ctyPath)
+		if !actual.HasError() {
+			t.Errorf("Error, validation failed for input: %s", input)
+		}
+	}
+}
+
+func TestValidateHealthEndpoint(t *testing.T) {
+	ctyPath := make(cty.Path, 0)
+	for _, input := range []string{"/login", "https://jenkins.example.com/healthz"} {
+		actual := validateHealthEndpoint(input, ctyPath)
+		if actual.HasError() {
+			t.Errorf("Error, validation failed for input: %s", input)
+		}
+	}
+
+	// Test if we fail when we should
+	input := "login"
+	actual := validateHealthEndpoint(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+}
+
+func TestValidateBuild(t *testing.T) {
+	ctyPath := make(cty.Path, 0)
+	for _, input := range []string{"lastSuccessfulBuild", "lastBuild", "12"} {
+		if actual := validateBuild(input, ctyPath); actual.HasError() {
+			t.Errorf("Error, validation failed for input: %s", input)
+		}
+	}
+
+	// Test if we fail when we should
+	for _, input := range []string{"0", "-1", "lastFailedBuilds", ""} {
+		if actual := validateBuild(input, ctyPath); !actual.HasError() {
+			t.Errorf("Error, negative validation failed for input: %s", input)
+		}
+	}
+}
+
+func TestValidatePositive(t *testing.T) {
+	ctyPath := make(cty.Path, 0)
+	if actual := validatePositive(1, ctyPath); actual.HasError() {
+		t.Errorf("Error, validation failed for input: %d", 1)
+	}
+
+	// Test if we fail when we should
+	for _, input := range []int{0, -1} {
+		if actual := validatePositive(input, ctyPath); !actual.HasError() {
+			t.Errorf("Error, negative validation failed for input: %d", input)
+		}
+	}
+}